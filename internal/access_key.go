@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"crypto/rand"
+)
+
+const accessKeyAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+// NewAccessKey generates an access key the way Shopware does, e.g. SWSC... for sales channels.
+func NewAccessKey(prefix string) string {
+	random := make([]byte, 26-len(prefix))
+
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+
+	key := []byte(prefix)
+
+	for _, b := range random {
+		key = append(key, accessKeyAlphabet[int(b)%len(accessKeyAlphabet)])
+	}
+
+	return string(key)
+}
+
+const secretAccessKeyAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
+
+// NewSecretAccessKey generates a secret the way Shopware does for integrations, 38 URL safe base64 characters.
+func NewSecretAccessKey() string {
+	random := make([]byte, 38)
+
+	if _, err := rand.Read(random); err != nil {
+		panic(err)
+	}
+
+	secret := make([]byte, 0, len(random))
+
+	for _, b := range random {
+		secret = append(secret, secretAccessKeyAlphabet[int(b)%len(secretAccessKeyAlphabet)])
+	}
+
+	return string(secret)
+}
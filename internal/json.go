@@ -0,0 +1,43 @@
+package internal
+
+import (
+	"encoding/json"
+	"reflect"
+)
+
+func DecodeJson(value string) (interface{}, error) {
+	var decoded interface{}
+
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return nil, err
+	}
+
+	return decoded, nil
+}
+
+func EncodeJson(value interface{}) (string, error) {
+	encoded, err := json.Marshal(value)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(encoded), nil
+}
+
+// JsonEqual reports whether both strings contain the same JSON document, ignoring formatting and key order.
+func JsonEqual(a, b string) bool {
+	decodedA, err := DecodeJson(a)
+
+	if err != nil {
+		return false
+	}
+
+	decodedB, err := DecodeJson(b)
+
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(decodedA, decodedB)
+}
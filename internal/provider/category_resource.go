@@ -0,0 +1,467 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CategoryResource{}
+var _ resource.ResourceWithImportState = &CategoryResource{}
+var _ resource.ResourceWithValidateConfig = &CategoryResource{}
+
+func NewCategoryResource() resource.Resource {
+	return &CategoryResource{}
+}
+
+// CategoryResource defines the resource implementation.
+type CategoryResource struct {
+	client *shopware_sdk.Client
+}
+
+// CategoryModel describes the resource data model.
+type CategoryModel struct {
+	Id              types.String `tfsdk:"id"`
+	ParentId        types.String `tfsdk:"parent_id"`
+	AfterCategoryId types.String `tfsdk:"after_category_id"`
+	Name            types.String `tfsdk:"name"`
+	Type            types.String `tfsdk:"type"`
+	Active          types.Bool   `tfsdk:"active"`
+	Visible         types.Bool   `tfsdk:"visible"`
+	Description     types.String `tfsdk:"description"`
+	MediaId         types.String `tfsdk:"media_id"`
+	CmsPageId       types.String `tfsdk:"cms_page_id"`
+	SlotConfig      types.String `tfsdk:"slot_config"`
+	LinkType        types.String `tfsdk:"link_type"`
+	ExternalLink    types.String `tfsdk:"external_link"`
+	InternalLink    types.String `tfsdk:"internal_link"`
+	LinkNewTab      types.Bool   `tfsdk:"link_new_tab"`
+	MetaTitle       types.String `tfsdk:"meta_title"`
+	MetaDescription types.String `tfsdk:"meta_description"`
+	Keywords        types.String `tfsdk:"keywords"`
+	CustomFields    types.String `tfsdk:"custom_fields"`
+	Translations    types.Map    `tfsdk:"translations"`
+}
+
+var categoryTranslations = translationDefinition{
+	entity:     "category",
+	foreignKey: "categoryId",
+	fields: map[string]string{
+		"name":             "name",
+		"description":      "description",
+		"external_link":    "externalLink",
+		"meta_title":       "metaTitle",
+		"meta_description": "metaDescription",
+		"keywords":         "keywords",
+	},
+}
+
+var categoryTypes = []string{"page", "link", "folder"}
+
+var categoryLinkTypes = []string{"external", "product", "category", "landing_page"}
+
+func (r *CategoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_category"
+}
+
+func (r *CategoryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Category. A category without parent can be used as navigation entry point of a Sales Channel.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Parent Category ID, the category is a root category when omitted",
+			},
+			"after_category_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the sibling this category is sorted after. When omitted, the position is not managed and changes made in the Administration are kept.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("page"),
+				MarkdownDescription: "Type, one of `page`, `link` or `folder`",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active flag",
+			},
+			"visible": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Show the category in the navigation",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"media_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Media ID of the category image",
+			},
+			"cms_page_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "CMS Page ID of the layout",
+			},
+			"slot_config": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded overrides of the layout slots, keyed by slot ID",
+			},
+			"link_type": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Link target for the `link` type, one of `external`, `product`, `category` or `landing_page`",
+			},
+			"external_link": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "URL for the `external` link type",
+			},
+			"internal_link": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the linked product, category or landing page",
+			},
+			"link_new_tab": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Open the link in a new tab",
+			},
+			"meta_title": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "SEO title",
+			},
+			"meta_description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "SEO description",
+			},
+			"keywords": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "SEO keywords",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": categoryTranslations.attribute(),
+		},
+	}
+}
+
+func (r *CategoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CategoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CategoryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create category, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CategoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CategoryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := searchEntities(ctx, r.client, "category", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	parentId, _ := entity["parentId"].(string)
+	name, _ := entity["name"].(string)
+	categoryType, _ := entity["type"].(string)
+	active, _ := entity["active"].(bool)
+	visible, _ := entity["visible"].(bool)
+	description, _ := entity["description"].(string)
+	mediaId, _ := entity["mediaId"].(string)
+	cmsPageId, _ := entity["cmsPageId"].(string)
+	linkType, _ := entity["linkType"].(string)
+	externalLink, _ := entity["externalLink"].(string)
+	internalLink, _ := entity["internalLink"].(string)
+	metaTitle, _ := entity["metaTitle"].(string)
+	metaDescription, _ := entity["metaDescription"].(string)
+	keywords, _ := entity["keywords"].(string)
+
+	data.ParentId = stringOrNull(parentId)
+	data.Name = types.StringValue(name)
+	data.Type = types.StringValue(categoryType)
+	data.Active = types.BoolValue(active)
+	data.Visible = types.BoolValue(visible)
+	data.Description = stringOrNull(description)
+	data.MediaId = stringOrNull(mediaId)
+	data.CmsPageId = stringOrNull(cmsPageId)
+	data.LinkType = stringOrNull(linkType)
+	data.ExternalLink = stringOrNull(externalLink)
+	data.InternalLink = stringOrNull(internalLink)
+	data.LinkNewTab = boolOrNull(entity["linkNewTab"], data.LinkNewTab)
+	data.MetaTitle = stringOrNull(metaTitle)
+	data.MetaDescription = stringOrNull(metaDescription)
+	data.Keywords = stringOrNull(keywords)
+
+	// Shopware relinks the siblings when categories are moved, so the position is only compared when it is managed.
+	if !data.AfterCategoryId.IsNull() {
+		afterCategoryId, _ := entity["afterCategoryId"].(string)
+		data.AfterCategoryId = stringOrNull(afterCategoryId)
+	}
+
+	slotConfig, err := jsonValue(entity["slotConfig"], data.SlotConfig)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category slot config, got error: %s", err))
+		return
+	}
+
+	data.SlotConfig = slotConfig
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := categoryTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read category translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CategoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CategoryModel
+	var state CategoryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update category, got error: %s", err))
+		return
+	}
+
+	if err := categoryTranslations.deleteRemoved(ctx, r.client, data.Id.ValueString(), state.Translations, data.Translations); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update category, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CategoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CategoryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	salesChannels, err := r.entryPointOf(ctx, data.Id.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete category, got error: %s", err))
+		return
+	}
+
+	if len(salesChannels) > 0 {
+		resp.Diagnostics.AddError(
+			"Category In Use",
+			fmt.Sprintf("The category is an entry point of the sales channels %s, change their entry points first.", strings.Join(salesChannels, ", ")),
+		)
+
+		return
+	}
+
+	_, err = r.client.Repository.Category.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete category, got error: %s", err))
+		return
+	}
+}
+
+func (r *CategoryResource) upsertData(ctx context.Context, data CategoryModel) error {
+	slotConfig, err := optionalJson(data.SlotConfig)
+
+	if err != nil {
+		return fmt.Errorf("slot config must be valid JSON: %w", err)
+	}
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := categoryTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":              data.Id.ValueString(),
+		"parentId":        optionalString(data.ParentId),
+		"name":            data.Name.ValueString(),
+		"type":            data.Type.ValueString(),
+		"active":          data.Active.ValueBool(),
+		"visible":         data.Visible.ValueBool(),
+		"description":     optionalString(data.Description),
+		"mediaId":         optionalString(data.MediaId),
+		"cmsPageId":       optionalString(data.CmsPageId),
+		"slotConfig":      slotConfig,
+		"linkType":        optionalString(data.LinkType),
+		"externalLink":    optionalString(data.ExternalLink),
+		"internalLink":    optionalString(data.InternalLink),
+		"linkNewTab":      optionalBool(data.LinkNewTab),
+		"metaTitle":       optionalString(data.MetaTitle),
+		"metaDescription": optionalString(data.MetaDescription),
+		"keywords":        optionalString(data.Keywords),
+		"customFields":    customFields,
+		"translations":    translations,
+	}
+
+	// An unmanaged position is left as it is.
+	if !data.AfterCategoryId.IsNull() {
+		payload["afterCategoryId"] = data.AfterCategoryId.ValueString()
+	}
+
+	return syncUpsert(ctx, r.client, "category", []map[string]interface{}{payload})
+}
+
+// entryPointOf returns the names of the sales channels using the category as navigation, footer or service entry point.
+func (r *CategoryResource) entryPointOf(ctx context.Context, categoryId string) ([]string, error) {
+	names := make([]string, 0)
+
+	for _, field := range []string{"navigationCategoryId", "footerCategoryId", "serviceCategoryId"} {
+		criteria := shopware_sdk.Criteria{
+			Filter: []shopware_sdk.CriteriaFilter{
+				{Type: shopware_sdk.SearchFilterTypeEquals, Field: field, Value: categoryId},
+			},
+		}
+
+		salesChannels, _, err := r.client.Repository.SalesChannel.SearchAll(shopware_sdk.NewApiContext(ctx), criteria)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, salesChannel := range salesChannels.Data {
+			if len(stringSliceIntersect(names, []string{salesChannel.Name})) == 0 {
+				names = append(names, salesChannel.Name)
+			}
+		}
+	}
+
+	return names, nil
+}
+
+func (r *CategoryResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CategoryModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(resp, path.Root("type"), "Invalid Category Type", data.Type, categoryTypes)
+
+	if data.LinkType.IsNull() || data.LinkType.IsUnknown() {
+		return
+	}
+
+	if !validateOneOf(resp, path.Root("link_type"), "Invalid Link Type", data.LinkType, categoryLinkTypes) {
+		return
+	}
+
+	if data.LinkType.ValueString() == "external" && data.ExternalLink.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("external_link"), "Missing External Link", "An external_link is required for the external link type.")
+	}
+
+	if data.LinkType.ValueString() != "external" && data.InternalLink.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("internal_link"), "Missing Internal Link", "An internal_link is required for internal link types.")
+	}
+}
+
+func (r *CategoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
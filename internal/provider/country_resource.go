@@ -0,0 +1,662 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CountryResource{}
+var _ resource.ResourceWithImportState = &CountryResource{}
+
+func NewCountryResource() resource.Resource {
+	return &CountryResource{}
+}
+
+// CountryResource defines the resource implementation.
+type CountryResource struct {
+	client *shopware_sdk.Client
+}
+
+// CountryModel describes the resource data model.
+type CountryModel struct {
+	Id                             types.String        `tfsdk:"id"`
+	Iso                            types.String        `tfsdk:"iso"`
+	Iso3                           types.String        `tfsdk:"iso3"`
+	Adopt                          types.Bool          `tfsdk:"adopt"`
+	Name                           types.String        `tfsdk:"name"`
+	Active                         types.Bool          `tfsdk:"active"`
+	ShippingAvailable              types.Bool          `tfsdk:"shipping_available"`
+	Position                       types.Int64         `tfsdk:"position"`
+	CustomerTaxFree                types.Bool          `tfsdk:"customer_tax_free"`
+	CompanyTaxFree                 types.Bool          `tfsdk:"company_tax_free"`
+	VatIdRequired                  types.Bool          `tfsdk:"vat_id_required"`
+	PostalCodeRequired             types.Bool          `tfsdk:"postal_code_required"`
+	CheckPostalCodePattern         types.Bool          `tfsdk:"check_postal_code_pattern"`
+	CheckAdvancedPostalCodePattern types.Bool          `tfsdk:"check_advanced_postal_code_pattern"`
+	AdvancedPostalCodePattern      types.String        `tfsdk:"advanced_postal_code_pattern"`
+	ForceStateInRegistration       types.Bool          `tfsdk:"force_state_in_registration"`
+	DisplayStateInRegistration     types.Bool          `tfsdk:"display_state_in_registration"`
+	AddressFormat                  types.List          `tfsdk:"address_format"`
+	CustomFields                   types.String        `tfsdk:"custom_fields"`
+	Translations                   types.Map           `tfsdk:"translations"`
+	States                         []CountryStateModel `tfsdk:"country_state"`
+}
+
+// CountryStateModel describes a state of the country, identified by its short code.
+type CountryStateModel struct {
+	ShortCode types.String `tfsdk:"short_code"`
+	Name      types.String `tfsdk:"name"`
+	Active    types.Bool   `tfsdk:"active"`
+	Position  types.Int64  `tfsdk:"position"`
+}
+
+var countryTranslations = translationDefinition{
+	entity:     "country",
+	foreignKey: "countryId",
+	fields: map[string]string{
+		"name": "name",
+	},
+}
+
+var countryAddressFormatType = types.ListType{ElemType: types.StringType}
+
+func (r *CountryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_country"
+}
+
+func (r *CountryResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Country. The countries shipped with Shopware can be configured with `adopt`, they are taken over by ISO code and kept on destroy.",
+
+		Blocks: map[string]schema.Block{
+			"country_state": schema.SetNestedBlock{
+				MarkdownDescription: "States, identified by their short code. States which are not listed are left untouched.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"short_code": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Short code, e.g. `DE-BY`",
+						},
+						"name": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Name",
+						},
+						"active": schema.BoolAttribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+							MarkdownDescription: "Active flag",
+						},
+						"position": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(1),
+							MarkdownDescription: "Position",
+						},
+					},
+				},
+			},
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iso": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ISO 3166-1 alpha-2 code, e.g. `DE`, changing it replaces an adopted country",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIf(
+						countryAdopted,
+						"Adopted countries are taken over by their ISO code.",
+						"Adopted countries are taken over by their ISO code.",
+					),
+				},
+			},
+			"iso3": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ISO 3166-1 alpha-3 code, e.g. `DEU`",
+			},
+			"adopt": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Take over the existing country with the ISO code instead of creating it. It is not deleted on destroy, imported countries can be adopted in place.",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active flag",
+			},
+			"shipping_available": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Allow shipping to the country",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(10),
+				MarkdownDescription: "Position",
+			},
+			"customer_tax_free": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Orders of private customers are tax free",
+			},
+			"company_tax_free": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Orders of companies are tax free",
+			},
+			"vat_id_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Require a VAT ID from companies",
+			},
+			"postal_code_required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Require a postal code",
+			},
+			"check_postal_code_pattern": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Validate postal codes with the default pattern of the country",
+			},
+			"check_advanced_postal_code_pattern": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Validate postal codes with `advanced_postal_code_pattern`",
+			},
+			"advanced_postal_code_pattern": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Regular expression postal codes have to match",
+			},
+			"force_state_in_registration": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Require a state in the registration",
+			},
+			"display_state_in_registration": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Show the state selection in the registration",
+			},
+			"address_format": schema.ListAttribute{
+				ElementType:         countryAddressFormatType,
+				Optional:            true,
+				MarkdownDescription: "Rows of the address format, each a list of snippet keys like `address/street`. The format of Shopware is kept when omitted.",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": countryTranslations.attribute(),
+		},
+	}
+}
+
+// countryAdopted replaces the country when the ISO code of an already adopted country changes, another country is
+// adopted then. Adopted countries are kept on destroy, so the replacement never deletes one.
+func countryAdopted(ctx context.Context, req planmodifier.StringRequest, resp *stringplanmodifier.RequiresReplaceIfFuncResponse) {
+	var adopt types.Bool
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("adopt"), &adopt)...)
+	resp.RequiresReplace = adopt.ValueBool()
+}
+
+func (r *CountryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CountryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CountryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if data.Adopt.ValueBool() {
+		id, err := r.findByIso(ctx, data.Iso.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt country, got error: %s", err))
+			return
+		}
+
+		if id == "" {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("iso"),
+				"Country Not Found",
+				fmt.Sprintf("No country with the ISO code %s exists, set adopt to false to create it.", data.Iso.ValueString()),
+			)
+
+			return
+		}
+
+		data.Id = types.StringValue(id)
+	}
+
+	if err := r.upsertData(ctx, data, CountryModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create country, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CountryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CountryModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"states": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "country", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read country, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	// Imported countries are managed like created ones unless adopt is configured.
+	if data.Adopt.IsNull() {
+		data.Adopt = types.BoolValue(false)
+	}
+
+	iso, _ := entity["iso"].(string)
+	iso3, _ := entity["iso3"].(string)
+	name, _ := entity["name"].(string)
+	position, _ := entity["position"].(float64)
+	advancedPostalCodePattern, _ := entity["advancedPostalCodePattern"].(string)
+	customerTax, _ := entity["customerTax"].(map[string]interface{})
+	companyTax, _ := entity["companyTax"].(map[string]interface{})
+	customerTaxFree, _ := customerTax["enabled"].(bool)
+	companyTaxFree, _ := companyTax["enabled"].(bool)
+
+	data.Iso = types.StringValue(iso)
+	data.Iso3 = stringOrNull(iso3)
+	data.Name = types.StringValue(name)
+	data.Position = types.Int64Value(int64(position))
+	data.CustomerTaxFree = types.BoolValue(customerTaxFree)
+	data.CompanyTaxFree = types.BoolValue(companyTaxFree)
+	data.AdvancedPostalCodePattern = stringOrNull(advancedPostalCodePattern)
+
+	flags := map[string]*types.Bool{
+		"active":                         &data.Active,
+		"shippingAvailable":              &data.ShippingAvailable,
+		"vatIdRequired":                  &data.VatIdRequired,
+		"postalCodeRequired":             &data.PostalCodeRequired,
+		"checkPostalCodePattern":         &data.CheckPostalCodePattern,
+		"checkAdvancedPostalCodePattern": &data.CheckAdvancedPostalCodePattern,
+		"forceStateInRegistration":       &data.ForceStateInRegistration,
+		"displayStateInRegistration":     &data.DisplayStateInRegistration,
+	}
+
+	for field, flag := range flags {
+		value, _ := entity[field].(bool)
+		*flag = types.BoolValue(value)
+	}
+
+	if !data.AddressFormat.IsNull() {
+		data.AddressFormat = countryAddressFormatFromApi(entity["addressFormat"])
+	}
+
+	states := make([]CountryStateModel, 0)
+	apiStates, _ := entity["states"].([]interface{})
+
+	// Only the states known to Terraform are compared, the states shipped with Shopware are kept.
+	for _, known := range data.States {
+		for _, element := range apiStates {
+			state, _ := element.(map[string]interface{})
+			shortCode, _ := state["shortCode"].(string)
+
+			if shortCode != known.ShortCode.ValueString() {
+				continue
+			}
+
+			stateName, _ := state["name"].(string)
+			active, _ := state["active"].(bool)
+			statePosition, _ := state["position"].(float64)
+
+			states = append(states, CountryStateModel{
+				ShortCode: types.StringValue(shortCode),
+				Name:      types.StringValue(stateName),
+				Active:    types.BoolValue(active),
+				Position:  types.Int64Value(int64(statePosition)),
+			})
+		}
+	}
+
+	data.States = states
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read country custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := countryTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read country translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CountryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CountryModel
+	var state CountryModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update country, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CountryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CountryModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopted countries are shipped with Shopware, they are only removed from the state.
+	if data.Adopt.ValueBool() {
+		return
+	}
+
+	_, err := r.client.Repository.Country.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete country, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the country and its configured states, and removes only the states which were dropped from the
+// configuration since the prior state.
+func (r *CountryResource) upsertData(ctx context.Context, data CountryModel, prior CountryModel) error {
+	countryId := data.Id.ValueString()
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{countryId},
+		Associations: map[string]shopware_sdk.Criteria{"states": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "country", criteria)
+
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]interface{}{}
+
+	if len(result.Data) > 0 {
+		existing = result.Data[0]
+	}
+
+	stateIds := map[string]string{}
+	existingStates, _ := existing["states"].([]interface{})
+
+	for _, element := range existingStates {
+		state, _ := element.(map[string]interface{})
+		id, _ := state["id"].(string)
+		shortCode, _ := state["shortCode"].(string)
+		stateIds[shortCode] = id
+	}
+
+	states := make([]map[string]interface{}, 0)
+
+	for _, state := range data.States {
+		id, ok := stateIds[state.ShortCode.ValueString()]
+
+		if !ok {
+			id = internal.NewUuid()
+		}
+
+		states = append(states, map[string]interface{}{
+			"id":        id,
+			"shortCode": state.ShortCode.ValueString(),
+			"name":      state.Name.ValueString(),
+			"active":    state.Active.ValueBool(),
+			"position":  state.Position.ValueInt64(),
+		})
+	}
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := countryTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":                             countryId,
+		"iso":                            data.Iso.ValueString(),
+		"iso3":                           optionalString(data.Iso3),
+		"name":                           data.Name.ValueString(),
+		"active":                         data.Active.ValueBool(),
+		"shippingAvailable":              data.ShippingAvailable.ValueBool(),
+		"position":                       data.Position.ValueInt64(),
+		"customerTax":                    countryTaxPayload(existing["customerTax"], data.CustomerTaxFree.ValueBool()),
+		"companyTax":                     countryTaxPayload(existing["companyTax"], data.CompanyTaxFree.ValueBool()),
+		"vatIdRequired":                  data.VatIdRequired.ValueBool(),
+		"postalCodeRequired":             data.PostalCodeRequired.ValueBool(),
+		"checkPostalCodePattern":         data.CheckPostalCodePattern.ValueBool(),
+		"checkAdvancedPostalCodePattern": data.CheckAdvancedPostalCodePattern.ValueBool(),
+		"advancedPostalCodePattern":      optionalString(data.AdvancedPostalCodePattern),
+		"forceStateInRegistration":       data.ForceStateInRegistration.ValueBool(),
+		"displayStateInRegistration":     data.DisplayStateInRegistration.ValueBool(),
+		"states":                         states,
+		"customFields":                   customFields,
+		"translations":                   translations,
+	}
+
+	if !data.AddressFormat.IsNull() {
+		addressFormat := make([][]string, 0)
+
+		for _, element := range data.AddressFormat.Elements() {
+			if row, ok := element.(types.List); ok {
+				addressFormat = append(addressFormat, stringListElements(row))
+			}
+		}
+
+		payload["addressFormat"] = addressFormat
+	}
+
+	if err := syncUpsert(ctx, r.client, "country", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, shortCode := range stringSliceDiff(countryStateShortCodes(prior.States), countryStateShortCodes(data.States)) {
+		if id, ok := stateIds[shortCode]; ok {
+			removed = append(removed, map[string]interface{}{"id": id})
+		}
+	}
+
+	if err := syncDelete(ctx, r.client, "country_state", removed); err != nil {
+		return err
+	}
+
+	return countryTranslations.deleteRemoved(ctx, r.client, countryId, prior.Translations, data.Translations)
+}
+
+func (r *CountryResource) findByIso(ctx context.Context, iso string) (string, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "iso", Value: iso},
+		},
+	}
+
+	ids, _, err := r.client.Repository.Country.SearchIds(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil || len(ids.Data) == 0 {
+		return "", err
+	}
+
+	return ids.Data[0], nil
+}
+
+// ImportState accepts the country ID or the ISO code.
+func (r *CountryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuidPattern.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := r.findByIso(ctx, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find country %s, got error: %s", req.ID, err))
+		return
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a country ID or an existing ISO code, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// countryTaxPayload toggles the tax free setting and keeps the amount and currency configured in the Administration.
+func countryTaxPayload(existing interface{}, enabled bool) map[string]interface{} {
+	tax := map[string]interface{}{
+		"enabled":    enabled,
+		"currencyId": defaultCurrencyId,
+		"amount":     0,
+	}
+
+	if current, ok := existing.(map[string]interface{}); ok {
+		for _, field := range []string{"currencyId", "amount"} {
+			if value, ok := current[field]; ok && value != nil {
+				tax[field] = value
+			}
+		}
+	}
+
+	return tax
+}
+
+func countryAddressFormatFromApi(value interface{}) types.List {
+	rows, _ := value.([]interface{})
+	elements := make([]attr.Value, 0)
+
+	for _, row := range rows {
+		keys, _ := row.([]interface{})
+		values := make([]attr.Value, 0)
+
+		for _, key := range keys {
+			if snippet, ok := key.(string); ok {
+				values = append(values, types.StringValue(snippet))
+			}
+		}
+
+		elements = append(elements, types.ListValueMust(types.StringType, values))
+	}
+
+	return types.ListValueMust(countryAddressFormatType, elements)
+}
+
+func countryStateShortCodes(states []CountryStateModel) []string {
+	shortCodes := make([]string, 0)
+
+	for _, state := range states {
+		shortCodes = append(shortCodes, state.ShortCode.ValueString())
+	}
+
+	return shortCodes
+}
@@ -0,0 +1,197 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CurrencyCountryRoundingResource{}
+var _ resource.ResourceWithImportState = &CurrencyCountryRoundingResource{}
+
+func NewCurrencyCountryRoundingResource() resource.Resource {
+	return &CurrencyCountryRoundingResource{}
+}
+
+// CurrencyCountryRoundingResource defines the resource implementation.
+type CurrencyCountryRoundingResource struct {
+	client *shopware_sdk.Client
+}
+
+// CurrencyCountryRoundingModel describes the resource data model.
+type CurrencyCountryRoundingModel struct {
+	Id            types.String   `tfsdk:"id"`
+	CurrencyId    types.String   `tfsdk:"currency_id"`
+	CountryId     types.String   `tfsdk:"country_id"`
+	ItemRounding  *RoundingModel `tfsdk:"item_rounding"`
+	TotalRounding *RoundingModel `tfsdk:"total_rounding"`
+}
+
+func (r *CurrencyCountryRoundingResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currency_country_rounding"
+}
+
+func (r *CurrencyCountryRoundingResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Rounding of a currency in one country, overrides the rounding of the currency",
+
+		Blocks: map[string]schema.Block{
+			"item_rounding":  roundingBlock("Rounding of line items"),
+			"total_rounding": roundingBlock("Rounding of the cart total"),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"currency_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Currency ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"country_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Country ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *CurrencyCountryRoundingResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CurrencyCountryRoundingResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CurrencyCountryRoundingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create currency country rounding, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyCountryRoundingResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CurrencyCountryRoundingModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := searchEntities(ctx, r.client, "currency_country_rounding", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency country rounding, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	currencyId, _ := entity["currencyId"].(string)
+	countryId, _ := entity["countryId"].(string)
+
+	data.CurrencyId = types.StringValue(currencyId)
+	data.CountryId = types.StringValue(countryId)
+	data.ItemRounding = roundingFromApi(entity["itemRounding"], data.ItemRounding)
+	data.TotalRounding = roundingFromApi(entity["totalRounding"], data.TotalRounding)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyCountryRoundingResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CurrencyCountryRoundingModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update currency country rounding, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyCountryRoundingResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CurrencyCountryRoundingModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "currency_country_rounding", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete currency country rounding, got error: %s", err))
+		return
+	}
+}
+
+func (r *CurrencyCountryRoundingResource) upsertData(ctx context.Context, data CurrencyCountryRoundingModel) error {
+	payload := map[string]interface{}{
+		"id":            data.Id.ValueString(),
+		"currencyId":    data.CurrencyId.ValueString(),
+		"countryId":     data.CountryId.ValueString(),
+		"itemRounding":  roundingPayload(data.ItemRounding),
+		"totalRounding": roundingPayload(data.TotalRounding),
+	}
+
+	return syncUpsert(ctx, r.client, "currency_country_rounding", []map[string]interface{}{payload})
+}
+
+func (r *CurrencyCountryRoundingResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
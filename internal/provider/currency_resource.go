@@ -0,0 +1,336 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CurrencyResource{}
+var _ resource.ResourceWithImportState = &CurrencyResource{}
+var _ resource.ResourceWithModifyPlan = &CurrencyResource{}
+
+// defaultCurrencyId is the ID of the system currency, all prices are stored relative to it.
+const defaultCurrencyId = "b7d2554b0ce847cd82f3ac9bd1c0dfca"
+
+func NewCurrencyResource() resource.Resource {
+	return &CurrencyResource{}
+}
+
+// CurrencyResource defines the resource implementation.
+type CurrencyResource struct {
+	client *shopware_sdk.Client
+}
+
+// CurrencyModel describes the resource data model.
+type CurrencyModel struct {
+	Id            types.String   `tfsdk:"id"`
+	IsoCode       types.String   `tfsdk:"iso_code"`
+	Name          types.String   `tfsdk:"name"`
+	ShortName     types.String   `tfsdk:"short_name"`
+	Symbol        types.String   `tfsdk:"symbol"`
+	Factor        types.Float64  `tfsdk:"factor"`
+	Position      types.Int64    `tfsdk:"position"`
+	TaxFreeFrom   types.Float64  `tfsdk:"tax_free_from"`
+	ItemRounding  *RoundingModel `tfsdk:"item_rounding"`
+	TotalRounding *RoundingModel `tfsdk:"total_rounding"`
+	CustomFields  types.String   `tfsdk:"custom_fields"`
+	Translations  types.Map      `tfsdk:"translations"`
+}
+
+var currencyTranslations = translationDefinition{
+	entity:     "currency",
+	foreignKey: "currencyId",
+	fields: map[string]string{
+		"name":       "name",
+		"short_name": "shortName",
+	},
+}
+
+func (r *CurrencyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_currency"
+}
+
+func (r *CurrencyResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Currency. The system currency can be imported and configured, but its factor can't be changed and it can't be deleted.",
+
+		Blocks: map[string]schema.Block{
+			"item_rounding":  roundingBlock("Rounding of line items"),
+			"total_rounding": roundingBlock("Rounding of the cart total"),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iso_code": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "ISO 4217 code, e.g. `CHF`",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"short_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Short name",
+			},
+			"symbol": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Symbol",
+			},
+			"factor": schema.Float64Attribute{
+				Required:            true,
+				MarkdownDescription: "Exchange rate relative to the system currency",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Position",
+			},
+			"tax_free_from": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Cart total from which orders are tax free, disabled when omitted",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": currencyTranslations.attribute(),
+		},
+	}
+}
+
+func (r *CurrencyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan refuses changes Shopware does not allow for the system currency, before anything is applied.
+func (r *CurrencyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state CurrencyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() || state.Id.ValueString() != defaultCurrencyId {
+		return
+	}
+
+	if req.Plan.Raw.IsNull() {
+		resp.Diagnostics.AddError(
+			"Default Currency",
+			"The system currency can't be deleted. Remove it from the state with `terraform state rm` instead.",
+		)
+
+		return
+	}
+
+	var factor types.Float64
+
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("factor"), &factor)...)
+
+	if !factor.IsUnknown() && factor.ValueFloat64() != 1 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("factor"),
+			"Default Currency",
+			"The factor of the system currency has to be 1, all other factors are relative to it.",
+		)
+	}
+}
+
+func (r *CurrencyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CurrencyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create currency, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CurrencyModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := searchEntities(ctx, r.client, "currency", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	isoCode, _ := entity["isoCode"].(string)
+	name, _ := entity["name"].(string)
+	shortName, _ := entity["shortName"].(string)
+	symbol, _ := entity["symbol"].(string)
+	factor, _ := entity["factor"].(float64)
+	position, _ := entity["position"].(float64)
+
+	data.IsoCode = types.StringValue(isoCode)
+	data.Name = types.StringValue(name)
+	data.ShortName = types.StringValue(shortName)
+	data.Symbol = types.StringValue(symbol)
+	data.Factor = types.Float64Value(factor)
+	data.Position = types.Int64Value(int64(position))
+	data.TaxFreeFrom = types.Float64Null()
+
+	if taxFreeFrom, ok := entity["taxFreeFrom"].(float64); ok && taxFreeFrom != 0 {
+		data.TaxFreeFrom = types.Float64Value(taxFreeFrom)
+	}
+
+	data.ItemRounding = roundingFromApi(entity["itemRounding"], data.ItemRounding)
+	data.TotalRounding = roundingFromApi(entity["totalRounding"], data.TotalRounding)
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := currencyTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read currency translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CurrencyModel
+	var state CurrencyModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update currency, got error: %s", err))
+		return
+	}
+
+	if err := currencyTranslations.deleteRemoved(ctx, r.client, data.Id.ValueString(), state.Translations, data.Translations); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update currency, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CurrencyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CurrencyModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Repository.Currency.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete currency, got error: %s", err))
+		return
+	}
+}
+
+func (r *CurrencyResource) upsertData(ctx context.Context, data CurrencyModel) error {
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := currencyTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":            data.Id.ValueString(),
+		"isoCode":       data.IsoCode.ValueString(),
+		"name":          data.Name.ValueString(),
+		"shortName":     data.ShortName.ValueString(),
+		"symbol":        data.Symbol.ValueString(),
+		"factor":        data.Factor.ValueFloat64(),
+		"position":      data.Position.ValueInt64(),
+		"taxFreeFrom":   data.TaxFreeFrom.ValueFloat64(),
+		"itemRounding":  roundingPayload(data.ItemRounding),
+		"totalRounding": roundingPayload(data.TotalRounding),
+		"customFields":  customFields,
+		"translations":  translations,
+	}
+
+	return syncUpsert(ctx, r.client, "currency", []map[string]interface{}{payload})
+}
+
+func (r *CurrencyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
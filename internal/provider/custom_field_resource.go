@@ -0,0 +1,335 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CustomFieldResource{}
+var _ resource.ResourceWithImportState = &CustomFieldResource{}
+var _ resource.ResourceWithValidateConfig = &CustomFieldResource{}
+
+func NewCustomFieldResource() resource.Resource {
+	return &CustomFieldResource{}
+}
+
+// CustomFieldResource defines the resource implementation.
+type CustomFieldResource struct {
+	client *shopware_sdk.Client
+}
+
+// CustomFieldModel describes the resource data model.
+type CustomFieldModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Name               types.String `tfsdk:"name"`
+	Type               types.String `tfsdk:"type"`
+	CustomFieldSetId   types.String `tfsdk:"custom_field_set_id"`
+	Label              types.Map    `tfsdk:"label"`
+	Position           types.Int64  `tfsdk:"position"`
+	Config             types.String `tfsdk:"config"`
+	Active             types.Bool   `tfsdk:"active"`
+	AllowCustomerWrite types.Bool   `tfsdk:"allow_customer_write"`
+}
+
+// customFieldTypes are the types known to the DAL, see CustomFieldTypes.
+var customFieldTypes = []string{
+	"bool", "checkbox", "colorpicker", "datetime", "entity", "float", "html", "int", "json", "media", "price", "select",
+	"switch", "text",
+}
+
+func (r *CustomFieldResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field"
+}
+
+func (r *CustomFieldResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom Field",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Technical name, the key of the values in the `customFields` of an entity. Changing it recreates the field, as stored values are not renamed.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Type, one of `" + strings.Join(customFieldTypes, "`, `") + "`",
+			},
+			"custom_field_set_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Custom Field Set ID",
+			},
+			"label": customFieldLabelAttribute(),
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Position within the set",
+			},
+			"config": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded config object, e.g. the `componentName` or `options` of select fields. Use `label` and `position` instead of their config keys.",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active",
+			},
+			"allow_customer_write": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Allow customers to write the field through the Store API",
+			},
+		},
+	}
+}
+
+func (r *CustomFieldResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomFieldResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomFieldModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomFieldModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := searchEntities(ctx, r.client, "custom_field", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	fieldType, _ := entity["type"].(string)
+	customFieldSetId, _ := entity["customFieldSetId"].(string)
+	active, _ := entity["active"].(bool)
+	allowCustomerWrite, _ := entity["allowCustomerWrite"].(bool)
+
+	data.Name = types.StringValue(name)
+	data.Type = types.StringValue(fieldType)
+	data.CustomFieldSetId = stringOrNull(customFieldSetId)
+	data.Active = types.BoolValue(active)
+	data.AllowCustomerWrite = types.BoolValue(allowCustomerWrite)
+
+	// The label and position are stored in the config, they are split off into their own attributes.
+	config := map[string]interface{}{}
+
+	if stored, ok := entity["config"].(map[string]interface{}); ok {
+		for key, value := range stored {
+			config[key] = value
+		}
+	}
+
+	data.Label = customFieldLabelValue(config["label"], data.Label)
+	data.Position = types.Int64Null()
+
+	if position, ok := config["customFieldPosition"].(float64); ok {
+		data.Position = types.Int64Value(int64(position))
+	}
+
+	delete(config, "label")
+	delete(config, "customFieldPosition")
+
+	if len(config) == 0 && data.Config.IsNull() {
+		data.Config = types.StringNull()
+	} else {
+		value, err := jsonValue(config, data.Config)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field config, got error: %s", err))
+			return
+		}
+
+		data.Config = value
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomFieldModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomFieldModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "custom_field", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field, got error: %s", err))
+		return
+	}
+}
+
+func (r *CustomFieldResource) upsertData(ctx context.Context, data CustomFieldModel) error {
+	config := map[string]interface{}{}
+
+	if decoded, err := optionalJson(data.Config); err != nil {
+		return fmt.Errorf("config must be valid JSON: %w", err)
+	} else if object, ok := decoded.(map[string]interface{}); ok {
+		config = object
+	}
+
+	if !data.Position.IsNull() && !data.Position.IsUnknown() {
+		config["customFieldPosition"] = data.Position.ValueInt64()
+	}
+
+	payload := map[string]interface{}{
+		"id":                 data.Id.ValueString(),
+		"name":               data.Name.ValueString(),
+		"type":               data.Type.ValueString(),
+		"customFieldSetId":   optionalString(data.CustomFieldSetId),
+		"config":             customFieldLabelConfig(data.Label, config),
+		"active":             data.Active.ValueBool(),
+		"allowCustomerWrite": data.AllowCustomerWrite.ValueBool(),
+	}
+
+	return syncUpsert(ctx, r.client, "custom_field", []map[string]interface{}{payload})
+}
+
+func (r *CustomFieldResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data CustomFieldModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(resp, path.Root("type"), "Invalid Custom Field Type", data.Type, customFieldTypes)
+
+	if data.Config.IsNull() || data.Config.IsUnknown() {
+		return
+	}
+
+	decoded, err := internal.DecodeJson(data.Config.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid JSON", err.Error())
+		return
+	}
+
+	config, ok := decoded.(map[string]interface{})
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("config"), "Invalid Config", "The config has to be a JSON object.")
+		return
+	}
+
+	for key, attribute := range map[string]string{"label": "label", "customFieldPosition": "position"} {
+		if _, ok := config[key]; ok {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("config"),
+				"Invalid Config",
+				fmt.Sprintf("The %s config key is managed by the %s attribute.", key, attribute),
+			)
+		}
+	}
+}
+
+// ImportState accepts the custom field ID or its technical name.
+func (r *CustomFieldResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuidPattern.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := searchIdByField(ctx, r.client, "custom_field", "name", req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find custom field %s, got error: %s", req.ID, err))
+		return
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a custom field ID or an existing technical name, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
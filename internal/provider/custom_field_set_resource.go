@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CustomFieldSetResource{}
+var _ resource.ResourceWithImportState = &CustomFieldSetResource{}
+
+func NewCustomFieldSetResource() resource.Resource {
+	return &CustomFieldSetResource{}
+}
+
+// CustomFieldSetResource defines the resource implementation.
+type CustomFieldSetResource struct {
+	client *shopware_sdk.Client
+}
+
+// CustomFieldSetModel describes the resource data model.
+type CustomFieldSetModel struct {
+	Id        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	Label     types.Map    `tfsdk:"label"`
+	Active    types.Bool   `tfsdk:"active"`
+	Global    types.Bool   `tfsdk:"global"`
+	Position  types.Int64  `tfsdk:"position"`
+	AppId     types.String `tfsdk:"app_id"`
+	Relations types.Set    `tfsdk:"relations"`
+}
+
+func (r *CustomFieldSetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_custom_field_set"
+}
+
+func (r *CustomFieldSetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Custom Field Set, groups custom fields and assigns them to entities",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Technical name",
+			},
+			"label": customFieldLabelAttribute(),
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active",
+			},
+			"global": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Global sets can't be edited in the Administration",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Position",
+			},
+			"app_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "ID of the app owning the set",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"relations": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Entity names the set is assigned to, e.g. `product` or `customer`. Relations added outside of Terraform are kept.",
+			},
+		},
+	}
+}
+
+func (r *CustomFieldSetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomFieldSetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomFieldSetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data, CustomFieldSetModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create custom field set, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldSetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomFieldSetModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"relations": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "custom_field_set", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read custom field set, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	active, _ := entity["active"].(bool)
+	global, _ := entity["global"].(bool)
+	position, _ := entity["position"].(float64)
+	appId, _ := entity["appId"].(string)
+	config, _ := entity["config"].(map[string]interface{})
+
+	data.Name = types.StringValue(name)
+	data.Label = customFieldLabelValue(config["label"], data.Label)
+	data.Active = types.BoolValue(active)
+	data.Global = types.BoolValue(global)
+	data.Position = types.Int64Value(int64(position))
+	data.AppId = stringOrNull(appId)
+
+	// Only the relations known to Terraform are compared, others might have been added by plugins.
+	if !data.Relations.IsNull() {
+		assigned := associationIds(entity["relations"], "entityName")
+		data.Relations = stringSetValue(stringSliceIntersect(stringSetElements(data.Relations), assigned))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldSetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomFieldSetModel
+	var state CustomFieldSetModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update custom field set, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomFieldSetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomFieldSetModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "custom_field_set", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete custom field set, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the set and its relations. Relations can only exist once per entity, so new ones are created
+// with their own ID and only the relations dropped since the prior state are removed.
+func (r *CustomFieldSetResource) upsertData(ctx context.Context, data CustomFieldSetModel, prior CustomFieldSetModel) error {
+	customFieldSetId := data.Id.ValueString()
+
+	payload := map[string]interface{}{
+		"id":       customFieldSetId,
+		"name":     data.Name.ValueString(),
+		"config":   customFieldLabelConfig(data.Label, map[string]interface{}{"translated": true}),
+		"active":   data.Active.ValueBool(),
+		"global":   data.Global.ValueBool(),
+		"position": data.Position.ValueInt64(),
+		"appId":    optionalString(data.AppId),
+	}
+
+	if err := syncUpsert(ctx, r.client, "custom_field_set", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "customFieldSetId", Value: customFieldSetId},
+		},
+	}
+
+	result, err := searchEntities(ctx, r.client, "custom_field_set_relation", criteria)
+
+	if err != nil {
+		return err
+	}
+
+	existing := map[string]string{}
+
+	for _, relation := range result.Data {
+		id, _ := relation["id"].(string)
+		entityName, _ := relation["entityName"].(string)
+		existing[entityName] = id
+	}
+
+	created := make([]map[string]interface{}, 0)
+
+	for _, entityName := range stringSliceDiff(stringSetElements(data.Relations), mapKeys(existing)) {
+		created = append(created, map[string]interface{}{
+			"id":               internal.NewUuid(),
+			"customFieldSetId": customFieldSetId,
+			"entityName":       entityName,
+		})
+	}
+
+	if len(created) > 0 {
+		if err := syncUpsert(ctx, r.client, "custom_field_set_relation", created); err != nil {
+			return err
+		}
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, entityName := range stringSliceDiff(stringSetElements(prior.Relations), stringSetElements(data.Relations)) {
+		if id, ok := existing[entityName]; ok {
+			removed = append(removed, map[string]interface{}{"id": id})
+		}
+	}
+
+	return syncDelete(ctx, r.client, "custom_field_set_relation", removed)
+}
+
+// ImportState accepts the custom field set ID or its technical name.
+func (r *CustomFieldSetResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuidPattern.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := searchIdByField(ctx, r.client, "custom_field_set", "name", req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find custom field set %s, got error: %s", req.ID, err))
+		return
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a custom field set ID or an existing technical name, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+func customFieldLabelAttribute() schema.MapAttribute {
+	return schema.MapAttribute{
+		ElementType:         types.StringType,
+		Optional:            true,
+		MarkdownDescription: "Labels keyed by locale code, e.g. `en-GB`",
+	}
+}
+
+// customFieldLabelConfig adds the labels to the config of a custom field or set, they are stored in the config JSON.
+func customFieldLabelConfig(label types.Map, config map[string]interface{}) map[string]interface{} {
+	if label.IsNull() || label.IsUnknown() {
+		return config
+	}
+
+	labels := map[string]interface{}{}
+
+	for locale, value := range label.Elements() {
+		if text, ok := value.(types.String); ok && !text.IsNull() && !text.IsUnknown() {
+			labels[locale] = text.ValueString()
+		}
+	}
+
+	config["label"] = labels
+
+	return config
+}
+
+// customFieldLabelValue reads the labels of a config, an omitted label attribute stays omitted while there are none.
+func customFieldLabelValue(value interface{}, prior types.Map) types.Map {
+	labels, _ := value.(map[string]interface{})
+
+	if len(labels) == 0 && prior.IsNull() {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := map[string]attr.Value{}
+
+	for locale, label := range labels {
+		if text, ok := label.(string); ok {
+			elements[locale] = types.StringValue(text)
+		}
+	}
+
+	return types.MapValueMust(types.StringType, elements)
+}
@@ -0,0 +1,339 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &CustomerGroupResource{}
+var _ resource.ResourceWithImportState = &CustomerGroupResource{}
+
+func NewCustomerGroupResource() resource.Resource {
+	return &CustomerGroupResource{}
+}
+
+// CustomerGroupResource defines the resource implementation.
+type CustomerGroupResource struct {
+	client *shopware_sdk.Client
+}
+
+// CustomerGroupModel describes the resource data model.
+type CustomerGroupModel struct {
+	Id                                  types.String `tfsdk:"id"`
+	Name                                types.String `tfsdk:"name"`
+	DisplayGross                        types.Bool   `tfsdk:"display_gross"`
+	RegistrationActive                  types.Bool   `tfsdk:"registration_active"`
+	RegistrationTitle                   types.String `tfsdk:"registration_title"`
+	RegistrationIntroduction            types.String `tfsdk:"registration_introduction"`
+	RegistrationOnlyCompanyRegistration types.Bool   `tfsdk:"registration_only_company_registration"`
+	RegistrationSeoMetaDescription      types.String `tfsdk:"registration_seo_meta_description"`
+	RegistrationSalesChannelIds         types.Set    `tfsdk:"registration_sales_channel_ids"`
+	RegistrationUrl                     types.String `tfsdk:"registration_url"`
+	CustomFields                        types.String `tfsdk:"custom_fields"`
+	Translations                        types.Map    `tfsdk:"translations"`
+}
+
+var customerGroupTranslations = translationDefinition{
+	entity:     "customer_group",
+	foreignKey: "customerGroupId",
+	fields: map[string]string{
+		"name":                              "name",
+		"registration_title":                "registrationTitle",
+		"registration_introduction":         "registrationIntroduction",
+		"registration_seo_meta_description": "registrationSeoMetaDescription",
+	},
+}
+
+func (r *CustomerGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_customer_group"
+}
+
+func (r *CustomerGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Customer Group, optionally with its own registration form",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"display_gross": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Display gross prices, net prices are shown otherwise",
+			},
+			"registration_active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Enable the registration form of the customer group",
+			},
+			"registration_title": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Title of the registration form",
+			},
+			"registration_introduction": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Introduction of the registration form",
+			},
+			"registration_only_company_registration": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Only allow companies to register",
+			},
+			"registration_seo_meta_description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "SEO description of the registration form",
+			},
+			"registration_sales_channel_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Sales Channel IDs the registration form is available in, sales channels assigned outside of Terraform are kept",
+			},
+			"registration_url": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Path of the registration form, relative to the Sales Channel domain",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": customerGroupTranslations.attribute(),
+		},
+	}
+}
+
+func (r *CustomerGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *CustomerGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data CustomerGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+	data.RegistrationUrl = types.StringValue(customerGroupRegistrationUrl(data.Id.ValueString()))
+
+	if err := r.upsertData(ctx, data, CustomerGroupModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create customer group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data CustomerGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"registrationSalesChannels": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "customer_group", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer group, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	displayGross, _ := entity["displayGross"].(bool)
+	registrationActive, _ := entity["registrationActive"].(bool)
+	registrationTitle, _ := entity["registrationTitle"].(string)
+	registrationIntroduction, _ := entity["registrationIntroduction"].(string)
+	registrationOnlyCompanyRegistration, _ := entity["registrationOnlyCompanyRegistration"].(bool)
+	registrationSeoMetaDescription, _ := entity["registrationSeoMetaDescription"].(string)
+
+	data.Name = types.StringValue(name)
+	data.DisplayGross = types.BoolValue(displayGross)
+	data.RegistrationActive = types.BoolValue(registrationActive)
+	data.RegistrationTitle = stringOrNull(registrationTitle)
+	data.RegistrationIntroduction = stringOrNull(registrationIntroduction)
+	data.RegistrationOnlyCompanyRegistration = types.BoolValue(registrationOnlyCompanyRegistration)
+	data.RegistrationSeoMetaDescription = stringOrNull(registrationSeoMetaDescription)
+	data.RegistrationUrl = types.StringValue(customerGroupRegistrationUrl(data.Id.ValueString()))
+
+	// Registration forms opened in further sales channels through the Administration stay open.
+	if !data.RegistrationSalesChannelIds.IsNull() {
+		assigned := associationIds(entity["registrationSalesChannels"], "id")
+		data.RegistrationSalesChannelIds = stringSetValue(stringSliceIntersect(stringSetElements(data.RegistrationSalesChannelIds), assigned))
+	}
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer group custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := customerGroupTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read customer group translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data CustomerGroupModel
+	var state CustomerGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update customer group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *CustomerGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data CustomerGroupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Repository.CustomerGroup.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete customer group, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the customer group and removes only the registration sales channels which were dropped from the
+// configuration since the prior state.
+func (r *CustomerGroupResource) upsertData(ctx context.Context, data CustomerGroupModel, prior CustomerGroupModel) error {
+	customerGroupId := data.Id.ValueString()
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := customerGroupTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	salesChannels := make([]map[string]interface{}, 0)
+
+	for _, id := range stringSetElements(data.RegistrationSalesChannelIds) {
+		salesChannels = append(salesChannels, map[string]interface{}{"id": id})
+	}
+
+	payload := map[string]interface{}{
+		"id":                                  customerGroupId,
+		"name":                                data.Name.ValueString(),
+		"displayGross":                        data.DisplayGross.ValueBool(),
+		"registrationActive":                  data.RegistrationActive.ValueBool(),
+		"registrationTitle":                   optionalString(data.RegistrationTitle),
+		"registrationIntroduction":            optionalString(data.RegistrationIntroduction),
+		"registrationOnlyCompanyRegistration": data.RegistrationOnlyCompanyRegistration.ValueBool(),
+		"registrationSeoMetaDescription":      optionalString(data.RegistrationSeoMetaDescription),
+		"registrationSalesChannels":           salesChannels,
+		"customFields":                        customFields,
+		"translations":                        translations,
+	}
+
+	if err := syncUpsert(ctx, r.client, "customer_group", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, id := range stringSliceDiff(stringSetElements(prior.RegistrationSalesChannelIds), stringSetElements(data.RegistrationSalesChannelIds)) {
+		removed = append(removed, map[string]interface{}{"customerGroupId": customerGroupId, "salesChannelId": id})
+	}
+
+	if err := syncDelete(ctx, r.client, "customer_group_registration_sales_channels", removed); err != nil {
+		return err
+	}
+
+	return customerGroupTranslations.deleteRemoved(ctx, r.client, customerGroupId, prior.Translations, data.Translations)
+}
+
+func (r *CustomerGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// customerGroupRegistrationUrl returns the storefront route of the registration form, SEO URLs redirect to it.
+func customerGroupRegistrationUrl(customerGroupId string) string {
+	return "/customer-group-registration/" + customerGroupId
+}
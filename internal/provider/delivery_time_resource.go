@@ -16,7 +16,7 @@ import (
 var _ resource.Resource = &DeliveryTimeResource{}
 var _ resource.ResourceWithImportState = &DeliveryTimeResource{}
 
-func NewSystemConfigResource() resource.Resource {
+func NewDeliveryTimeResource() resource.Resource {
 	return &DeliveryTimeResource{}
 }
 
@@ -27,11 +27,18 @@ type DeliveryTimeResource struct {
 
 // DeliveryTimeModel describes the resource data model.
 type DeliveryTimeModel struct {
-	Id      types.String  `tfsdk:"id"`
-	Name    types.String  `tfsdk:"name"`
-	Unit    types.String  `tfsdk:"unit"`
-	Minimum types.Float64 `tfsdk:"minimum"`
-	Maximum types.Float64 `tfsdk:"maximum"`
+	Id           types.String  `tfsdk:"id"`
+	Name         types.String  `tfsdk:"name"`
+	Unit         types.String  `tfsdk:"unit"`
+	Minimum      types.Float64 `tfsdk:"minimum"`
+	Maximum      types.Float64 `tfsdk:"maximum"`
+	Translations types.Map     `tfsdk:"translations"`
+}
+
+var deliveryTimeTranslations = translationDefinition{
+	entity:     "delivery_time",
+	foreignKey: "deliveryTimeId",
+	fields:     map[string]string{"name": "name"},
 }
 
 func (r *DeliveryTimeResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +73,7 @@ func (r *DeliveryTimeResource) Schema(ctx context.Context, req resource.SchemaRe
 				Required:            true,
 				MarkdownDescription: "Maximum",
 			},
+			"translations": deliveryTimeTranslations.attribute(),
 		},
 	}
 }
@@ -137,13 +145,24 @@ func (r *DeliveryTimeResource) Read(ctx context.Context, req resource.ReadReques
 	data.Minimum = types.Float64Value(entity.Min)
 	data.Maximum = types.Float64Value(entity.Max)
 
+	translations, err := deliveryTimeTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read delivery time translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *DeliveryTimeResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data DeliveryTimeModel
+	var state DeliveryTimeModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
@@ -154,6 +173,11 @@ func (r *DeliveryTimeResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	if err := deliveryTimeTranslations.deleteRemoved(ctx, r.client, data.Id.ValueString(), state.Translations, data.Translations); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update delivery time, got error: %s", err))
+		return
+	}
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
@@ -179,20 +203,22 @@ func (r *DeliveryTimeResource) Delete(ctx context.Context, req resource.DeleteRe
 }
 
 func (r *DeliveryTimeResource) upsertData(ctx context.Context, data DeliveryTimeModel) error {
-	_, err := r.client.Repository.DeliveryTime.Upsert(
-		shopware_sdk.NewApiContext(ctx),
-		[]shopware_sdk.DeliveryTime{
-			{
-				Id:   data.Id.ValueString(),
-				Name: data.Name.ValueString(),
-				Min:  data.Minimum.ValueFloat64(),
-				Max:  data.Maximum.ValueFloat64(),
-				Unit: data.Unit.ValueString(),
-			},
-		},
-	)
+	translations, err := deliveryTimeTranslations.payload(ctx, r.client, data.Translations)
 
-	return err
+	if err != nil {
+		return err
+	}
+
+	return syncUpsert(ctx, r.client, "delivery_time", []map[string]interface{}{
+		{
+			"id":           data.Id.ValueString(),
+			"name":         data.Name.ValueString(),
+			"min":          data.Minimum.ValueFloat64(),
+			"max":          data.Maximum.ValueFloat64(),
+			"unit":         data.Unit.ValueString(),
+			"translations": translations,
+		},
+	})
 }
 
 func (r *DeliveryTimeResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &EntityResource{}
+var _ resource.ResourceWithImportState = &EntityResource{}
+var _ resource.ResourceWithValidateConfig = &EntityResource{}
+
+func NewEntityResource() resource.Resource {
+	return &EntityResource{}
+}
+
+// EntityResource manages any entity by a plain JSON payload, e.g. entities of plugins without a typed resource.
+type EntityResource struct {
+	client *shopware_sdk.Client
+}
+
+// EntityModel describes the resource data model.
+type EntityModel struct {
+	Id      types.String `tfsdk:"id"`
+	Entity  types.String `tfsdk:"entity"`
+	Payload types.String `tfsdk:"payload"`
+}
+
+func (r *EntityResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity"
+}
+
+func (r *EntityResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Any DAL entity, written through the sync API. Only the fields of the payload are compared on refresh, so fields maintained by Shopware do not show up as drift.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Identifier, generated when omitted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"entity": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Entity name, e.g. `product_stream` or `swag_paypal_pos_sales_channel`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"payload": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "JSON encoded object of the fields to write, e.g. `jsonencode({ name = \"Sale\", filters = [] })`",
+			},
+		},
+	}
+}
+
+func (r *EntityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *EntityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data EntityModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsUnknown() || data.Id.IsNull() {
+		data.Id = types.StringValue(internal.NewUuid())
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create %s, got error: %s", data.Entity.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data EntityModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity := data.Entity.ValueString()
+
+	result, err := searchEntities(ctx, r.client, entity, shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", entity, err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// An imported entity has no payload yet, the configured one is written with the next apply.
+	if !data.Payload.IsNull() {
+		prior, err := internal.DecodeJson(data.Payload.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to decode payload of %s, got error: %s", entity, err))
+			return
+		}
+
+		data.Payload, err = jsonValue(entityPayloadFields(prior, result.Data[0]), data.Payload)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read %s, got error: %s", entity, err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data EntityModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update %s, got error: %s", data.Entity.ValueString(), err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EntityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data EntityModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, data.Entity.ValueString(), []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete %s, got error: %s", data.Entity.ValueString(), err))
+		return
+	}
+}
+
+func (r *EntityResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data EntityModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Payload.IsUnknown() || data.Payload.IsNull() {
+		return
+	}
+
+	payload, err := internal.DecodeJson(data.Payload.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("payload"), "Invalid Payload", fmt.Sprintf("Expected a JSON object, got error: %s", err))
+		return
+	}
+
+	object, ok := payload.(map[string]interface{})
+
+	if !ok {
+		resp.Diagnostics.AddAttributeError(path.Root("payload"), "Invalid Payload", "Expected a JSON object.")
+		return
+	}
+
+	if _, ok := object["id"]; ok {
+		resp.Diagnostics.AddAttributeError(path.Root("payload"), "Invalid Payload", "The id has to be set with the id attribute instead of the payload.")
+	}
+}
+
+func (r *EntityResource) upsertData(ctx context.Context, data EntityModel) error {
+	payload, err := internal.DecodeJson(data.Payload.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	object, ok := payload.(map[string]interface{})
+
+	if !ok {
+		return fmt.Errorf("payload must be a JSON object")
+	}
+
+	object["id"] = data.Id.ValueString()
+
+	return syncUpsert(ctx, r.client, data.Entity.ValueString(), []map[string]interface{}{object})
+}
+
+func (r *EntityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	entity, id, found := strings.Cut(req.ID, ":")
+
+	if !found || entity == "" || id == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected an ID in the format entity:id, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("entity"), entity)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
+// entityPayloadFields takes the current values of the fields set in the prior payload, nested objects are compared
+// the same way. Fields missing in the API response keep their prior value, just like lists and objects which are
+// returned as null, as the search returns unloaded associations that way.
+func entityPayloadFields(prior interface{}, current interface{}) interface{} {
+	priorObject, ok := prior.(map[string]interface{})
+
+	if !ok {
+		return current
+	}
+
+	currentObject, ok := current.(map[string]interface{})
+
+	if !ok {
+		return current
+	}
+
+	fields := map[string]interface{}{}
+
+	for key, value := range priorObject {
+		currentValue, ok := currentObject[key]
+
+		if ok && currentValue == nil {
+			switch value.(type) {
+			case []interface{}, map[string]interface{}:
+				ok = false
+			}
+		}
+
+		if ok {
+			fields[key] = entityPayloadFields(value, currentValue)
+		} else {
+			fields[key] = value
+		}
+	}
+
+	return fields
+}
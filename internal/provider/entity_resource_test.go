@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"terraform-provider-shopware/internal"
+	"testing"
+)
+
+func TestEntityPayloadFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    string
+		current  string
+		expected string
+	}{
+		{
+			name:     "fields outside of the payload are ignored",
+			prior:    `{"name": "Stream"}`,
+			current:  `{"id": "a", "name": "Stream", "createdAt": "2024-01-01"}`,
+			expected: `{"name": "Stream"}`,
+		},
+		{
+			name:     "changed fields are reported",
+			prior:    `{"name": "Stream", "description": "Old"}`,
+			current:  `{"name": "Renamed", "description": null}`,
+			expected: `{"name": "Renamed", "description": null}`,
+		},
+		{
+			name:     "missing fields keep their prior value",
+			prior:    `{"name": "Stream", "filters": []}`,
+			current:  `{"name": "Stream"}`,
+			expected: `{"name": "Stream", "filters": []}`,
+		},
+		{
+			name:     "unloaded associations keep their prior value",
+			prior:    `{"name": "Stream", "filters": [], "media": {"id": "b"}}`,
+			current:  `{"name": "Stream", "filters": null, "media": null}`,
+			expected: `{"name": "Stream", "filters": [], "media": {"id": "b"}}`,
+		},
+		{
+			name:     "nested objects are compared field by field",
+			prior:    `{"customFields": {"color": "red"}}`,
+			current:  `{"customFields": {"color": "blue", "size": "XL"}}`,
+			expected: `{"customFields": {"color": "blue"}}`,
+		},
+		{
+			name:     "scalars replacing objects are reported",
+			prior:    `{"price": {"net": 1}}`,
+			current:  `{"price": 1}`,
+			expected: `{"price": 1}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			prior, err := internal.DecodeJson(test.prior)
+
+			if err != nil {
+				t.Fatalf("invalid prior payload: %s", err)
+			}
+
+			current, err := internal.DecodeJson(test.current)
+
+			if err != nil {
+				t.Fatalf("invalid current payload: %s", err)
+			}
+
+			actual, err := internal.EncodeJson(entityPayloadFields(prior, current))
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if !internal.JsonEqual(actual, test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
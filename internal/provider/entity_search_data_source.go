@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+var _ datasource.DataSource = &EntitySearchDataSource{}
+
+func NewEntitySearchDataSource() datasource.DataSource {
+	return &EntitySearchDataSource{}
+}
+
+// EntitySearchDataSource runs a DAL search for any entity, including entities of plugins and apps.
+type EntitySearchDataSource struct {
+	client *shopware_sdk.Client
+}
+
+type EntitySearchDataSourceModel struct {
+	Entity       types.String            `tfsdk:"entity"`
+	Filters      types.String            `tfsdk:"filters"`
+	Sort         []EntitySearchSortModel `tfsdk:"sort"`
+	Limit        types.Int64             `tfsdk:"limit"`
+	Associations types.List              `tfsdk:"associations"`
+	Includes     types.Map               `tfsdk:"includes"`
+	Total        types.Int64             `tfsdk:"total"`
+	Ids          types.List              `tfsdk:"ids"`
+	Result       types.String            `tfsdk:"result"`
+}
+
+type EntitySearchSortModel struct {
+	Field          types.String `tfsdk:"field"`
+	Order          types.String `tfsdk:"order"`
+	NaturalSorting types.Bool   `tfsdk:"natural_sorting"`
+}
+
+func (d *EntitySearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_entity_search"
+}
+
+func (d *EntitySearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Searches any entity with the DAL criteria of the Admin API",
+
+		Attributes: map[string]schema.Attribute{
+			"entity": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Entity name, e.g. `product` or `swag_paypal_pos_sales_channel`",
+			},
+			"filters": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded list of DAL filters, e.g. `jsonencode([{ type = \"equals\", field = \"active\", value = true }])`",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Maximum number of results",
+			},
+			"associations": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Associations to load, nested associations are separated by dots, e.g. `media.thumbnails`",
+			},
+			"includes": schema.MapAttribute{
+				ElementType:         types.ListType{ElemType: types.StringType},
+				Optional:            true,
+				MarkdownDescription: "Fields to return keyed by entity name. The `id` of the searched entity is always returned.",
+			},
+			"total": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Total number of matching entities, regardless of the limit",
+			},
+			"ids": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Computed:            true,
+				MarkdownDescription: "IDs of the found entities",
+			},
+			"result": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "JSON encoded list of the found entities, use `jsondecode` to access it",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"sort": schema.ListNestedBlock{
+				MarkdownDescription: "Sortings, applied in the given order",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Field to sort by",
+						},
+						"order": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Sort order, `ASC` (default) or `DESC`",
+						},
+						"natural_sorting": schema.BoolAttribute{
+							Optional:            true,
+							MarkdownDescription: "Sort numbers within strings naturally",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EntitySearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EntitySearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data EntitySearchDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	entity := data.Entity.ValueString()
+
+	criteria := map[string]interface{}{
+		"totalCountMode": shopware_sdk.TotalCountModeExact,
+	}
+
+	filters, err := optionalJson(data.Filters)
+
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("filters"), "Invalid JSON", fmt.Sprintf("Unable to decode filters, got error: %s", err))
+		return
+	}
+
+	if filters != nil {
+		criteria["filter"] = filters
+	}
+
+	if !data.Limit.IsNull() {
+		criteria["limit"] = data.Limit.ValueInt64()
+	}
+
+	if len(data.Sort) > 0 {
+		sortings := make([]map[string]interface{}, 0)
+
+		for i, sorting := range data.Sort {
+			order := "ASC"
+
+			if !sorting.Order.IsNull() {
+				order = strings.ToUpper(sorting.Order.ValueString())
+			}
+
+			if order != "ASC" && order != "DESC" {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("sort").AtListIndex(i).AtName("order"),
+					"Invalid Sort Order",
+					fmt.Sprintf("Expected ASC or DESC, got: %s", sorting.Order.ValueString()),
+				)
+
+				return
+			}
+
+			sortings = append(sortings, map[string]interface{}{
+				"field":          sorting.Field.ValueString(),
+				"order":          order,
+				"naturalSorting": sorting.NaturalSorting.ValueBool(),
+			})
+		}
+
+		criteria["sort"] = sortings
+	}
+
+	if !data.Associations.IsNull() {
+		associations := map[string]interface{}{}
+
+		for _, association := range stringListElements(data.Associations) {
+			entitySearchAssociation(associations, strings.Split(association, "."))
+		}
+
+		criteria["associations"] = associations
+	}
+
+	if !data.Includes.IsNull() {
+		includes := map[string][]string{}
+
+		for name, element := range data.Includes.Elements() {
+			if fields, ok := element.(types.List); ok {
+				includes[name] = stringListElements(fields)
+			}
+		}
+
+		// The IDs are always needed for the ids attribute.
+		if fields, ok := includes[entity]; ok {
+			includes[entity] = append(stringSliceDiff(fields, []string{"id"}), "id")
+		}
+
+		criteria["includes"] = includes
+	}
+
+	result, err := searchEntities(ctx, d.client, entity, criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search %s, got error: %s", entity, err))
+		return
+	}
+
+	ids := make([]attr.Value, 0)
+
+	for _, entry := range result.Data {
+		id, _ := entry["id"].(string)
+		ids = append(ids, types.StringValue(id))
+	}
+
+	encoded, err := internal.EncodeJson(result.Data)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to encode %s search result, got error: %s", entity, err))
+		return
+	}
+
+	data.Total = types.Int64Value(result.Total)
+	data.Ids = types.ListValueMust(types.StringType, ids)
+	data.Result = types.StringValue(encoded)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// entitySearchAssociation adds a dotted association path like media.thumbnails to the nested criteria.
+func entitySearchAssociation(associations map[string]interface{}, parts []string) {
+	association, ok := associations[parts[0]].(map[string]interface{})
+
+	if !ok {
+		association = map[string]interface{}{}
+		associations[parts[0]] = association
+	}
+
+	if len(parts) == 1 {
+		return
+	}
+
+	nested, ok := association["associations"].(map[string]interface{})
+
+	if !ok {
+		nested = map[string]interface{}{}
+		association["associations"] = nested
+	}
+
+	entitySearchAssociation(nested, parts[1:])
+}
@@ -0,0 +1,318 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &FlowResource{}
+var _ resource.ResourceWithImportState = &FlowResource{}
+var _ resource.ResourceWithValidateConfig = &FlowResource{}
+
+func NewFlowResource() resource.Resource {
+	return &FlowResource{}
+}
+
+// FlowResource defines the resource implementation.
+type FlowResource struct {
+	client *shopware_sdk.Client
+}
+
+// FlowModel describes the resource data model.
+type FlowModel struct {
+	Id           types.String `tfsdk:"id"`
+	Name         types.String `tfsdk:"name"`
+	EventName    types.String `tfsdk:"event_name"`
+	Description  types.String `tfsdk:"description"`
+	Priority     types.Int64  `tfsdk:"priority"`
+	Active       types.Bool   `tfsdk:"active"`
+	Sequences    types.List   `tfsdk:"sequence"`
+	CustomFields types.String `tfsdk:"custom_fields"`
+}
+
+func (r *FlowResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_flow"
+}
+
+func (r *FlowResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Flow Builder flow. The sequences are written as a whole, changes made in the Administration show up as drift.",
+
+		Blocks: map[string]schema.Block{
+			"sequence": flowSequenceBlock(flowSequenceMaxDepth, "Sequences run when the event is dispatched"),
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"event_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Event triggering the flow, e.g. `checkout.order.placed`",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"priority": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Priority, flows of the same event with a higher priority run first",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+		},
+	}
+}
+
+func (r *FlowResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FlowResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data FlowModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create flow, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FlowResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data FlowModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"sequences": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "flow", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read flow, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	eventName, _ := entity["eventName"].(string)
+	description, _ := entity["description"].(string)
+	priority, _ := entity["priority"].(float64)
+	active, _ := entity["active"].(bool)
+
+	data.Name = types.StringValue(name)
+	data.EventName = types.StringValue(eventName)
+	data.Description = stringOrNull(description)
+	data.Priority = types.Int64Value(int64(priority))
+	data.Active = types.BoolValue(active)
+
+	entities := make([]map[string]interface{}, 0)
+	sequences, _ := entity["sequences"].([]interface{})
+
+	for _, element := range sequences {
+		if sequence, ok := element.(map[string]interface{}); ok {
+			entities = append(entities, sequence)
+		}
+	}
+
+	tree, err := buildFlowSequenceTree(entities, "", false)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read flow sequences, got error: %s", err))
+		return
+	}
+
+	keepFlowSequenceConfigs(flowSequencesFromList(data.Sequences), tree)
+
+	list, diags := flowSequencesToList(tree, flowSequenceMaxDepth)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Sequences = list
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read flow custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FlowResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data FlowModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update flow, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FlowResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data FlowModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "flow", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete flow, got error: %s", err))
+		return
+	}
+}
+
+// upsertData replaces the flow and all of its sequences in a single sync request, which Shopware runs in one
+// transaction, so a failing write never leaves a flow with half of its sequences behind.
+func (r *FlowResource) upsertData(ctx context.Context, data FlowModel) error {
+	flowId := data.Id.ValueString()
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	sequences, err := flattenFlowSequences(flowId, nil, false, flowSequencesFromList(data.Sequences))
+
+	if err != nil {
+		return err
+	}
+
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "flowId", Value: flowId},
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "parentId", Value: nil},
+		},
+	}
+
+	existing, err := searchEntities(ctx, r.client, "flow_sequence", criteria)
+
+	if err != nil {
+		return err
+	}
+
+	// Children are removed together with their root sequence.
+	removed := make([]map[string]interface{}, 0)
+
+	for _, sequence := range existing.Data {
+		removed = append(removed, map[string]interface{}{"id": sequence["id"]})
+	}
+
+	payload := map[string]interface{}{
+		"id":           flowId,
+		"name":         data.Name.ValueString(),
+		"eventName":    data.EventName.ValueString(),
+		"description":  optionalString(data.Description),
+		"priority":     data.Priority.ValueInt64(),
+		"active":       data.Active.ValueBool(),
+		"sequences":    sequences,
+		"customFields": customFields,
+	}
+
+	// The old sequences have to be gone before the new ones are written.
+	return syncOperations(
+		ctx,
+		r.client,
+		shopware_sdk.SyncOperation{Entity: "flow_sequence", Action: "delete", Payload: removed},
+		shopware_sdk.SyncOperation{Entity: "flow", Action: "upsert", Payload: []map[string]interface{}{payload}},
+	)
+}
+
+func (r *FlowResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FlowModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(validateFlowSequences(flowSequencesFromList(data.Sequences))...)
+}
+
+func (r *FlowResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,356 @@
+package provider
+
+import (
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-shopware/internal"
+)
+
+// flowSequenceMaxDepth limits how deep conditions can be nested, Terraform schemas cannot be recursive.
+const flowSequenceMaxDepth = 5
+
+// FlowSequenceModel describes a condition or an action of a flow, conditions hold the sequences of both branches.
+type FlowSequenceModel struct {
+	RuleId          types.String
+	Action          types.String
+	AppFlowActionId types.String
+	Config          types.String
+	TrueCase        []FlowSequenceModel
+	FalseCase       []FlowSequenceModel
+}
+
+func flowSequenceBlock(depth int, description string) schema.ListNestedBlock {
+	blocks := map[string]schema.Block{}
+
+	if depth > 1 {
+		blocks["true_case"] = flowSequenceBlock(depth-1, "Sequences run when the rule of the condition matches")
+		blocks["false_case"] = flowSequenceBlock(depth-1, "Sequences run when the rule of the condition does not match")
+	}
+
+	return schema.ListNestedBlock{
+		MarkdownDescription: description + ". Each sequence is either a condition with a `rule_id` or an action.",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"rule_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Rule ID of a condition",
+				},
+				"action": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "Action name, e.g. `action.mail.send`, `action.add.order.tag`, `action.set.order.state` or the name of an app flow action calling a webhook",
+				},
+				"app_flow_action_id": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "App Flow Action ID, required by Shopware for actions of apps",
+				},
+				"config": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "JSON encoded action config, e.g. `jsonencode({ mailTemplateId = \"...\", recipient = { type = \"default\", data = [] } })`",
+				},
+			},
+			Blocks: blocks,
+		},
+	}
+}
+
+func flowSequenceObjectType(depth int) types.ObjectType {
+	attributeTypes := map[string]attr.Type{
+		"rule_id":            types.StringType,
+		"action":             types.StringType,
+		"app_flow_action_id": types.StringType,
+		"config":             types.StringType,
+	}
+
+	if depth > 1 {
+		attributeTypes["true_case"] = types.ListType{ElemType: flowSequenceObjectType(depth - 1)}
+		attributeTypes["false_case"] = types.ListType{ElemType: flowSequenceObjectType(depth - 1)}
+	}
+
+	return types.ObjectType{AttrTypes: attributeTypes}
+}
+
+func flowSequencesFromList(list types.List) []FlowSequenceModel {
+	sequences := make([]FlowSequenceModel, 0)
+
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+
+		if !ok {
+			continue
+		}
+
+		attributes := object.Attributes()
+		sequence := FlowSequenceModel{}
+		sequence.RuleId, _ = attributes["rule_id"].(types.String)
+		sequence.Action, _ = attributes["action"].(types.String)
+		sequence.AppFlowActionId, _ = attributes["app_flow_action_id"].(types.String)
+		sequence.Config, _ = attributes["config"].(types.String)
+
+		if children, ok := attributes["true_case"].(types.List); ok {
+			sequence.TrueCase = flowSequencesFromList(children)
+		}
+
+		if children, ok := attributes["false_case"].(types.List); ok {
+			sequence.FalseCase = flowSequencesFromList(children)
+		}
+
+		sequences = append(sequences, sequence)
+	}
+
+	return sequences
+}
+
+func flowSequencesToList(sequences []FlowSequenceModel, depth int) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectType := flowSequenceObjectType(depth)
+	elements := make([]attr.Value, 0)
+
+	for _, sequence := range sequences {
+		attributes := map[string]attr.Value{
+			"rule_id":            sequence.RuleId,
+			"action":             sequence.Action,
+			"app_flow_action_id": sequence.AppFlowActionId,
+			"config":             sequence.Config,
+		}
+
+		if depth > 1 {
+			trueCase, trueDiags := flowSequencesToList(sequence.TrueCase, depth-1)
+			falseCase, falseDiags := flowSequencesToList(sequence.FalseCase, depth-1)
+			diags.Append(trueDiags...)
+			diags.Append(falseDiags...)
+			attributes["true_case"] = trueCase
+			attributes["false_case"] = falseCase
+		} else if len(sequence.TrueCase) > 0 || len(sequence.FalseCase) > 0 {
+			diags.AddError(
+				"Unsupported Flow Sequence Tree",
+				fmt.Sprintf("Flow conditions can be nested at most %d levels deep", flowSequenceMaxDepth),
+			)
+		}
+
+		element, elementDiags := types.ObjectValue(objectType.AttrTypes, attributes)
+		diags.Append(elementDiags...)
+		elements = append(elements, element)
+	}
+
+	list, listDiags := types.ListValue(objectType, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+func validateFlowSequences(sequences []FlowSequenceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, sequence := range sequences {
+		isCondition := !sequence.RuleId.IsNull()
+		isAction := !sequence.Action.IsNull()
+
+		if isCondition == isAction {
+			diags.AddAttributeError(
+				path.Root("sequence"),
+				"Invalid Flow Sequence",
+				"Each sequence needs either a rule_id for a condition or an action.",
+			)
+		}
+
+		if isCondition && !sequence.Config.IsNull() {
+			diags.AddAttributeError(
+				path.Root("sequence"),
+				"Invalid Flow Sequence",
+				fmt.Sprintf("The condition with rule %s can't have a config, only actions have one.", sequence.RuleId.ValueString()),
+			)
+		}
+
+		if isCondition && !sequence.AppFlowActionId.IsNull() {
+			diags.AddAttributeError(
+				path.Root("sequence"),
+				"Invalid Flow Sequence",
+				fmt.Sprintf("The condition with rule %s can't have an app_flow_action_id, only actions have one.", sequence.RuleId.ValueString()),
+			)
+		}
+
+		if isAction && (len(sequence.TrueCase) > 0 || len(sequence.FalseCase) > 0) {
+			diags.AddAttributeError(
+				path.Root("sequence"),
+				"Invalid Flow Sequence",
+				fmt.Sprintf("The action %s can't have branches, only conditions have them.", sequence.Action.ValueString()),
+			)
+		}
+
+		if !sequence.Config.IsNull() && !sequence.Config.IsUnknown() {
+			decoded, err := internal.DecodeJson(sequence.Config.ValueString())
+
+			if err != nil {
+				diags.AddAttributeError(
+					path.Root("sequence"),
+					"Invalid Action Config",
+					fmt.Sprintf("The config of action %s must be valid JSON, got error: %s", sequence.Action.ValueString(), err),
+				)
+			} else if _, ok := decoded.(map[string]interface{}); !ok {
+				diags.AddAttributeError(
+					path.Root("sequence"),
+					"Invalid Action Config",
+					fmt.Sprintf("The config of action %s has to be a JSON object.", sequence.Action.ValueString()),
+				)
+			}
+		}
+
+		diags.Append(validateFlowSequences(sequence.TrueCase)...)
+		diags.Append(validateFlowSequences(sequence.FalseCase)...)
+	}
+
+	return diags
+}
+
+// flattenFlowSequences turns the sequence tree into the flat list of flow_sequence entities the API expects.
+func flattenFlowSequences(flowId string, parentId interface{}, trueCase bool, sequences []FlowSequenceModel) ([]map[string]interface{}, error) {
+	flat := make([]map[string]interface{}, 0)
+
+	for position, sequence := range sequences {
+		config := map[string]interface{}{}
+
+		if !sequence.Config.IsNull() {
+			decoded, err := internal.DecodeJson(sequence.Config.ValueString())
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid config of action %s: %w", sequence.Action.ValueString(), err)
+			}
+
+			if object, ok := decoded.(map[string]interface{}); ok {
+				config = object
+			}
+		}
+
+		id := internal.NewUuid()
+
+		flat = append(flat, map[string]interface{}{
+			"id":              id,
+			"flowId":          flowId,
+			"parentId":        parentId,
+			"ruleId":          optionalString(sequence.RuleId),
+			"actionName":      optionalString(sequence.Action),
+			"appFlowActionId": optionalString(sequence.AppFlowActionId),
+			"config":          config,
+			"position":        position + 1,
+			"trueCase":        trueCase,
+		})
+
+		for _, branch := range []struct {
+			trueCase  bool
+			sequences []FlowSequenceModel
+		}{{true, sequence.TrueCase}, {false, sequence.FalseCase}} {
+			children, err := flattenFlowSequences(flowId, id, branch.trueCase, branch.sequences)
+
+			if err != nil {
+				return nil, err
+			}
+
+			flat = append(flat, children...)
+		}
+	}
+
+	return flat, nil
+}
+
+// buildFlowSequenceTree rebuilds the sequence tree of one branch from the flat flow_sequence entities.
+func buildFlowSequenceTree(entities []map[string]interface{}, parentId string, trueCase bool) ([]FlowSequenceModel, error) {
+	children := make([]map[string]interface{}, 0)
+
+	for _, entity := range entities {
+		entityParentId, _ := entity["parentId"].(string)
+		entityTrueCase, _ := entity["trueCase"].(bool)
+
+		// Root sequences have no branch, their trueCase flag is meaningless.
+		if entityParentId == parentId && (parentId == "" || entityTrueCase == trueCase) {
+			children = append(children, entity)
+		}
+	}
+
+	// Positions are not unique when sequences were created in the Administration, the id keeps the order stable.
+	sort.Slice(children, func(i, j int) bool {
+		positionI, _ := children[i]["position"].(float64)
+		positionJ, _ := children[j]["position"].(float64)
+
+		if positionI == positionJ {
+			idI, _ := children[i]["id"].(string)
+			idJ, _ := children[j]["id"].(string)
+
+			return idI < idJ
+		}
+
+		return positionI < positionJ
+	})
+
+	sequences := make([]FlowSequenceModel, 0)
+
+	for _, child := range children {
+		id, _ := child["id"].(string)
+		ruleId, _ := child["ruleId"].(string)
+		actionName, _ := child["actionName"].(string)
+		appFlowActionId, _ := child["appFlowActionId"].(string)
+
+		sequence := FlowSequenceModel{
+			RuleId:          stringOrNull(ruleId),
+			Action:          stringOrNull(actionName),
+			AppFlowActionId: stringOrNull(appFlowActionId),
+			Config:          types.StringNull(),
+		}
+
+		if config, ok := child["config"]; ok && config != nil {
+			value, err := internal.EncodeJson(config)
+
+			if err != nil {
+				return nil, err
+			}
+
+			// Conditions and actions without options store an empty config.
+			if !isEmptyJson(types.StringValue(value)) {
+				sequence.Config = types.StringValue(value)
+			}
+		}
+
+		trueBranch, err := buildFlowSequenceTree(entities, id, true)
+
+		if err != nil {
+			return nil, err
+		}
+
+		falseBranch, err := buildFlowSequenceTree(entities, id, false)
+
+		if err != nil {
+			return nil, err
+		}
+
+		sequence.TrueCase = trueBranch
+		sequence.FalseCase = falseBranch
+		sequences = append(sequences, sequence)
+	}
+
+	return sequences, nil
+}
+
+// keepFlowSequenceConfigs carries over the configured config strings for sequences whose configs are semantically
+// unchanged, so differences in JSON formatting or key order do not show up as drift.
+func keepFlowSequenceConfigs(prior []FlowSequenceModel, current []FlowSequenceModel) {
+	for i := range current {
+		if i >= len(prior) || prior[i].Action.ValueString() != current[i].Action.ValueString() {
+			continue
+		}
+
+		switch {
+		case current[i].Config.IsNull() && isEmptyJson(prior[i].Config):
+			current[i].Config = prior[i].Config
+		case !prior[i].Config.IsNull() && !current[i].Config.IsNull() && internal.JsonEqual(prior[i].Config.ValueString(), current[i].Config.ValueString()):
+			current[i].Config = prior[i].Config
+		}
+
+		keepFlowSequenceConfigs(prior[i].TrueCase, current[i].TrueCase)
+		keepFlowSequenceConfigs(prior[i].FalseCase, current[i].FalseCase)
+	}
+}
@@ -0,0 +1,284 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-shopware/internal"
+	"testing"
+)
+
+// flowAction builds an action for the tests, an empty config stands for null.
+func flowAction(action string, config string) FlowSequenceModel {
+	sequence := FlowSequenceModel{
+		RuleId:          types.StringNull(),
+		Action:          types.StringValue(action),
+		AppFlowActionId: types.StringNull(),
+		Config:          types.StringNull(),
+	}
+
+	if config != "" {
+		sequence.Config = types.StringValue(config)
+	}
+
+	return sequence
+}
+
+// flowCondition builds a condition with both of its branches for the tests.
+func flowCondition(ruleId string, trueCase []FlowSequenceModel, falseCase []FlowSequenceModel) FlowSequenceModel {
+	return FlowSequenceModel{
+		RuleId:          types.StringValue(ruleId),
+		Action:          types.StringNull(),
+		AppFlowActionId: types.StringNull(),
+		Config:          types.StringNull(),
+		TrueCase:        trueCase,
+		FalseCase:       falseCase,
+	}
+}
+
+// formatFlowSequences renders a sequence tree compactly, so mismatches are readable in the test output.
+func formatFlowSequences(sequences []FlowSequenceModel) string {
+	parts := make([]string, 0, len(sequences))
+
+	for _, sequence := range sequences {
+		var part string
+
+		if !sequence.RuleId.IsNull() {
+			part = "if " + sequence.RuleId.ValueString() + " then [" + formatFlowSequences(sequence.TrueCase) + "] else [" + formatFlowSequences(sequence.FalseCase) + "]"
+		} else {
+			part = sequence.Action.ValueString()
+		}
+
+		if !sequence.AppFlowActionId.IsNull() {
+			part += " app " + sequence.AppFlowActionId.ValueString()
+		}
+
+		if !sequence.Config.IsNull() {
+			part += " " + sequence.Config.ValueString()
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// flowSequenceEntities passes the flattened sequences through JSON, like the API returns them.
+func flowSequenceEntities(t *testing.T, flat []map[string]interface{}) []map[string]interface{} {
+	encoded, err := internal.EncodeJson(flat)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	decoded, err := internal.DecodeJson(encoded)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	list, _ := decoded.([]interface{})
+	entities := make([]map[string]interface{}, 0, len(list))
+
+	// The API returns the sequences in any order, the tree must not depend on it.
+	for i := len(list) - 1; i >= 0; i-- {
+		entity, _ := list[i].(map[string]interface{})
+		entities = append(entities, entity)
+	}
+
+	return entities
+}
+
+func TestFlowSequenceTreeRoundTrip(t *testing.T) {
+	appAction := flowAction("telegram.send.message", `{"chat": "orders"}`)
+	appAction.AppFlowActionId = types.StringValue("app-action")
+
+	tests := []struct {
+		name      string
+		sequences []FlowSequenceModel
+		expected  string
+	}{
+		{
+			name:      "empty",
+			sequences: []FlowSequenceModel{},
+			expected:  "",
+		},
+		{
+			name: "actions",
+			sequences: []FlowSequenceModel{
+				flowAction("action.add.order.tag", `{"entity": "order", "tagIds": {"a": "VIP"}}`),
+				flowAction("action.stop.flow", ""),
+				appAction,
+			},
+			expected: `action.add.order.tag {"entity":"order","tagIds":{"a":"VIP"}}, action.stop.flow, telegram.send.message app app-action {"chat":"orders"}`,
+		},
+		{
+			name: "nested conditions",
+			sequences: []FlowSequenceModel{
+				flowCondition("vip",
+					[]FlowSequenceModel{
+						flowCondition("large", []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId": "b"}`)}, nil),
+						flowAction("action.add.order.tag", ""),
+					},
+					[]FlowSequenceModel{flowAction("action.stop.flow", "")},
+				),
+				flowAction("action.set.order.state", `{"order": "in_progress"}`),
+			},
+			expected: `if vip then [if large then [action.mail.send {"mailTemplateId":"b"}] else [], action.add.order.tag] else [action.stop.flow], action.set.order.state {"order":"in_progress"}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flat, err := flattenFlowSequences("flow", nil, false, test.sequences)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			tree, err := buildFlowSequenceTree(flowSequenceEntities(t, flat), "", false)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual := formatFlowSequences(tree); actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestBuildFlowSequenceTreeOrder(t *testing.T) {
+	// Sequences created in the Administration share their positions, the id decides then. The trueCase flag of root
+	// sequences is meaningless.
+	entities := []map[string]interface{}{
+		{"id": "c", "actionName": "third", "position": float64(2), "trueCase": true},
+		{"id": "b", "ruleId": "rule", "position": float64(1), "trueCase": false},
+		{"id": "a", "actionName": "first", "position": float64(1), "trueCase": false},
+		{"id": "d", "parentId": "b", "actionName": "matched", "position": float64(1), "trueCase": true},
+		{"id": "e", "parentId": "b", "actionName": "missed", "position": float64(1), "trueCase": false},
+	}
+
+	tree, err := buildFlowSequenceTree(entities, "", false)
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if actual, expected := formatFlowSequences(tree), "first, if rule then [matched] else [missed], third"; actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestKeepFlowSequenceConfigs(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    []FlowSequenceModel
+		current  []FlowSequenceModel
+		expected string
+	}{
+		{
+			name:     "reformatted config keeps the configured string",
+			prior:    []FlowSequenceModel{flowAction("action.mail.send", `{ "mailTemplateId": "b" }`)},
+			current:  []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId":"b"}`)},
+			expected: `action.mail.send { "mailTemplateId": "b" }`,
+		},
+		{
+			name:     "changed config is reported",
+			prior:    []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId": "b"}`)},
+			current:  []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId":"c"}`)},
+			expected: `action.mail.send {"mailTemplateId":"c"}`,
+		},
+		{
+			name:     "configured empty config is kept",
+			prior:    []FlowSequenceModel{flowAction("action.stop.flow", "{}")},
+			current:  []FlowSequenceModel{flowAction("action.stop.flow", "")},
+			expected: "action.stop.flow {}",
+		},
+		{
+			name:     "changed action is not carried over",
+			prior:    []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId": "b"}`)},
+			current:  []FlowSequenceModel{flowAction("action.add.order.tag", `{"mailTemplateId":"b"}`)},
+			expected: `action.add.order.tag {"mailTemplateId":"b"}`,
+		},
+		{
+			name: "branches are compared as well",
+			prior: []FlowSequenceModel{
+				flowCondition("vip", []FlowSequenceModel{flowAction("action.mail.send", `{ "mailTemplateId": "b" }`)}, nil),
+			},
+			current: []FlowSequenceModel{
+				flowCondition("vip", []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId":"b"}`)}, nil),
+			},
+			expected: `if vip then [action.mail.send { "mailTemplateId": "b" }] else []`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keepFlowSequenceConfigs(test.prior, test.current)
+
+			if actual := formatFlowSequences(test.current); actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestValidateFlowSequences(t *testing.T) {
+	conditionWithConfig := flowCondition("vip", nil, nil)
+	conditionWithConfig.Config = types.StringValue("{}")
+
+	conditionWithAppAction := flowCondition("vip", nil, nil)
+	conditionWithAppAction.AppFlowActionId = types.StringValue("app-action")
+
+	actionWithBranch := flowAction("action.stop.flow", "")
+	actionWithBranch.TrueCase = []FlowSequenceModel{flowAction("action.stop.flow", "")}
+
+	tests := []struct {
+		name      string
+		sequences []FlowSequenceModel
+		valid     bool
+	}{
+		{
+			name:      "valid tree",
+			sequences: []FlowSequenceModel{flowCondition("vip", []FlowSequenceModel{flowAction("action.mail.send", `{"mailTemplateId": "b"}`)}, nil)},
+			valid:     true,
+		},
+		{
+			name:      "neither rule nor action",
+			sequences: []FlowSequenceModel{{RuleId: types.StringNull(), Action: types.StringNull(), AppFlowActionId: types.StringNull(), Config: types.StringNull()}},
+		},
+		{
+			name:      "condition with config",
+			sequences: []FlowSequenceModel{conditionWithConfig},
+		},
+		{
+			name:      "condition with app flow action",
+			sequences: []FlowSequenceModel{conditionWithAppAction},
+		},
+		{
+			name:      "action with branches",
+			sequences: []FlowSequenceModel{actionWithBranch},
+		},
+		{
+			name:      "invalid JSON config",
+			sequences: []FlowSequenceModel{flowAction("action.mail.send", "{")},
+		},
+		{
+			name:      "config which is not an object",
+			sequences: []FlowSequenceModel{flowAction("action.mail.send", `["b"]`)},
+		},
+		{
+			name:      "invalid nested sequence",
+			sequences: []FlowSequenceModel{flowCondition("vip", nil, []FlowSequenceModel{flowAction("action.mail.send", `"b"`)})},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if diags := validateFlowSequences(test.sequences); diags.HasError() == test.valid {
+				t.Errorf("expected valid to be %t, got %v", test.valid, diags)
+			}
+		})
+	}
+}
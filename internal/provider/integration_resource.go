@@ -0,0 +1,254 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &IntegrationResource{}
+var _ resource.ResourceWithImportState = &IntegrationResource{}
+
+func NewIntegrationResource() resource.Resource {
+	return &IntegrationResource{}
+}
+
+// IntegrationResource defines the resource implementation.
+type IntegrationResource struct {
+	client *shopware_sdk.Client
+}
+
+// IntegrationModel describes the resource data model.
+type IntegrationModel struct {
+	Id              types.String `tfsdk:"id"`
+	Label           types.String `tfsdk:"label"`
+	Admin           types.Bool   `tfsdk:"admin"`
+	AclRoleIds      types.Set    `tfsdk:"acl_role_ids"`
+	AccessKey       types.String `tfsdk:"access_key"`
+	SecretAccessKey types.String `tfsdk:"secret_access_key"`
+}
+
+func (r *IntegrationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_integration"
+}
+
+func (r *IntegrationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Integration, the `access_key` and `secret_access_key` can be used as `client_id` and `client_secret` of the Admin API",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Label",
+			},
+			"admin": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Grant all permissions, the ACL roles are ignored then",
+			},
+			"acl_role_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "ACL Role IDs, roles assigned outside of Terraform are kept",
+			},
+			"access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Access key, generated on creation",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"secret_access_key": schema.StringAttribute{
+				Computed:            true,
+				Sensitive:           true,
+				MarkdownDescription: "Secret access key, generated on creation. Shopware only stores its hash, so it is empty for imported integrations.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *IntegrationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *IntegrationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data IntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+	data.AccessKey = types.StringValue(internal.NewAccessKey("SWIA"))
+	data.SecretAccessKey = types.StringValue(internal.NewSecretAccessKey())
+
+	if err := r.upsertData(ctx, data, IntegrationModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create integration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data IntegrationModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"aclRoles": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "integration", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read integration, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	label, _ := entity["label"].(string)
+	admin, _ := entity["admin"].(bool)
+	accessKey, _ := entity["accessKey"].(string)
+
+	data.Label = types.StringValue(label)
+	data.Admin = types.BoolValue(admin)
+	data.AccessKey = types.StringValue(accessKey)
+
+	// The secret is only returned as a hash, the generated one is kept and imported integrations have none.
+	if data.SecretAccessKey.IsNull() {
+		data.SecretAccessKey = types.StringValue("")
+	}
+
+	// Roles granted in the Administration, e.g. while debugging an integration, are not revoked.
+	if !data.AclRoleIds.IsNull() {
+		assigned := associationIds(entity["aclRoles"], "id")
+		data.AclRoleIds = stringSetValue(stringSliceIntersect(stringSetElements(data.AclRoleIds), assigned))
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data IntegrationModel
+	var state IntegrationModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update integration, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *IntegrationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data IntegrationModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "integration", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete integration, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the integration, the credentials are only written on creation as Shopware hashes the secret.
+func (r *IntegrationResource) upsertData(ctx context.Context, data IntegrationModel, prior IntegrationModel) error {
+	integrationId := data.Id.ValueString()
+
+	aclRoles := make([]map[string]interface{}, 0)
+
+	for _, id := range stringSetElements(data.AclRoleIds) {
+		aclRoles = append(aclRoles, map[string]interface{}{"id": id})
+	}
+
+	payload := map[string]interface{}{
+		"id":       integrationId,
+		"label":    data.Label.ValueString(),
+		"admin":    data.Admin.ValueBool(),
+		"aclRoles": aclRoles,
+	}
+
+	if prior.Id.IsNull() {
+		payload["accessKey"] = data.AccessKey.ValueString()
+		payload["secretAccessKey"] = data.SecretAccessKey.ValueString()
+	}
+
+	if err := syncUpsert(ctx, r.client, "integration", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, id := range stringSliceDiff(stringSetElements(prior.AclRoleIds), stringSetElements(data.AclRoleIds)) {
+		removed = append(removed, map[string]interface{}{"integrationId": integrationId, "aclRoleId": id})
+	}
+
+	return syncDelete(ctx, r.client, "integration_role", removed)
+}
+
+func (r *IntegrationResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
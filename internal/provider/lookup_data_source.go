@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var _ datasource.DataSource = &LookupDataSource{}
+
+// lookupField maps a Terraform attribute to a field of the entity.
+type lookupField struct {
+	attribute   string
+	field       string
+	description string
+}
+
+// lookupOutput is a computed attribute read from a field of the found entity.
+type lookupOutput struct {
+	lookupField
+	kind string
+}
+
+const (
+	lookupOutputString = "string"
+	lookupOutputNumber = "number"
+	lookupOutputBool   = "bool"
+)
+
+// LookupDataSource finds exactly one entity by equality filters, e.g. a currency by its ISO code.
+type LookupDataSource struct {
+	client *shopware_sdk.Client
+
+	typeName    string
+	entity      string
+	description string
+	filters     []lookupField
+	outputs     []lookupOutput
+}
+
+func NewCurrencyDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "currency",
+		entity:      "currency",
+		description: "Looks up a Currency",
+		filters: []lookupField{
+			{attribute: "iso_code", field: "isoCode", description: "ISO code, e.g. `EUR`"},
+			{attribute: "name", field: "name", description: "Name"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "symbol", field: "symbol", description: "Symbol"}, lookupOutputString},
+			{lookupField{attribute: "short_name", field: "shortName", description: "Short name"}, lookupOutputString},
+			{lookupField{attribute: "factor", field: "factor", description: "Factor relative to the default currency"}, lookupOutputNumber},
+		},
+	}
+}
+
+func NewCountryDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "country",
+		entity:      "country",
+		description: "Looks up a Country",
+		filters: []lookupField{
+			{attribute: "iso", field: "iso", description: "ISO 3166-1 alpha-2 code, e.g. `DE`"},
+			{attribute: "iso3", field: "iso3", description: "ISO 3166-1 alpha-3 code, e.g. `DEU`"},
+			{attribute: "name", field: "name", description: "Name"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "active", field: "active", description: "Active flag"}, lookupOutputBool},
+		},
+	}
+}
+
+func NewLanguageDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "language",
+		entity:      "language",
+		description: "Looks up a Language",
+		filters: []lookupField{
+			{attribute: "locale_code", field: "locale.code", description: "ISO locale, e.g. `de-DE`"},
+			{attribute: "name", field: "name", description: "Name"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "locale_id", field: "localeId", description: "Locale ID"}, lookupOutputString},
+			{lookupField{attribute: "translation_code_id", field: "translationCodeId", description: "Locale ID used for snippets"}, lookupOutputString},
+		},
+	}
+}
+
+func NewTaxDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "tax",
+		entity:      "tax",
+		description: "Looks up a Tax",
+		filters: []lookupField{
+			{attribute: "name", field: "name", description: "Name, e.g. `Standard rate`"},
+			{attribute: "tax_rate", field: "taxRate", description: "Tax rate, e.g. `19`"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "position", field: "position", description: "Position"}, lookupOutputNumber},
+		},
+	}
+}
+
+func NewCustomerGroupDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "customer_group",
+		entity:      "customer_group",
+		description: "Looks up a Customer Group",
+		filters: []lookupField{
+			{attribute: "name", field: "name", description: "Name"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "display_gross", field: "displayGross", description: "Display gross prices"}, lookupOutputBool},
+		},
+	}
+}
+
+func NewSalesChannelDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "sales_channel",
+		entity:      "sales_channel",
+		description: "Looks up a Sales Channel",
+		filters: []lookupField{
+			{attribute: "name", field: "name", description: "Name"},
+			{attribute: "access_key", field: "accessKey", description: "Store API access key"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "type_id", field: "typeId", description: "Sales Channel Type ID"}, lookupOutputString},
+			{lookupField{attribute: "navigation_category_id", field: "navigationCategoryId", description: "Navigation entry point Category ID"}, lookupOutputString},
+		},
+	}
+}
+
+func NewPaymentMethodDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "payment_method",
+		entity:      "payment_method",
+		description: "Looks up a Payment Method",
+		filters: []lookupField{
+			{attribute: "technical_name", field: "technicalName", description: "Technical name"},
+			{attribute: "handler_identifier", field: "handlerIdentifier", description: "Handler identifier"},
+			{attribute: "name", field: "name", description: "Name"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "active", field: "active", description: "Active flag"}, lookupOutputBool},
+		},
+	}
+}
+
+func NewDeliveryTimeDataSource() datasource.DataSource {
+	return &LookupDataSource{
+		typeName:    "delivery_time",
+		entity:      "delivery_time",
+		description: "Looks up a Delivery Time",
+		filters: []lookupField{
+			{attribute: "name", field: "name", description: "Name"},
+		},
+		outputs: []lookupOutput{
+			{lookupField{attribute: "unit", field: "unit", description: "Unit"}, lookupOutputString},
+			{lookupField{attribute: "minimum", field: "min", description: "Minimum"}, lookupOutputNumber},
+			{lookupField{attribute: "maximum", field: "max", description: "Maximum"}, lookupOutputNumber},
+		},
+	}
+}
+
+func (d *LookupDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + d.typeName
+}
+
+func (d *LookupDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: "Identifier",
+		},
+	}
+
+	for _, filter := range d.filters {
+		attributes[filter.attribute] = schema.StringAttribute{
+			Optional:            true,
+			Computed:            true,
+			MarkdownDescription: filter.description,
+		}
+	}
+
+	for _, output := range d.outputs {
+		switch output.kind {
+		case lookupOutputNumber:
+			attributes[output.attribute] = schema.Float64Attribute{Computed: true, MarkdownDescription: output.description}
+		case lookupOutputBool:
+			attributes[output.attribute] = schema.BoolAttribute{Computed: true, MarkdownDescription: output.description}
+		default:
+			attributes[output.attribute] = schema.StringAttribute{Computed: true, MarkdownDescription: output.description}
+		}
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: d.description + ". All given attributes have to match and exactly one result has to be found.",
+		Attributes:          attributes,
+	}
+}
+
+func (d *LookupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *LookupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	criteria := shopware_sdk.Criteria{}
+	fields := append([]lookupField{{attribute: "id", field: "id"}}, d.filters...)
+	configured := map[string]types.String{}
+
+	for _, field := range fields {
+		var value types.String
+
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(field.attribute), &value)...)
+
+		if value.IsNull() {
+			continue
+		}
+
+		configured[field.attribute] = value
+
+		criteria.Filter = append(criteria.Filter, shopware_sdk.CriteriaFilter{
+			Type:  shopware_sdk.SearchFilterTypeEquals,
+			Field: field.field,
+			Value: value.ValueString(),
+		})
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(criteria.Filter) == 0 {
+		resp.Diagnostics.AddError("Missing Filter", fmt.Sprintf("At least one attribute has to be set to look up a %s.", d.typeName))
+		return
+	}
+
+	// Dotted fields like locale.code are only returned when their association is loaded.
+	for _, field := range append(fields, lookupOutputFields(d.outputs)...) {
+		criteria.Associations = lookupAssociations(criteria.Associations, field.field)
+	}
+
+	// Two results are enough to tell that the lookup is ambiguous.
+	criteria.Limit = 2
+	criteria.TotalCountMode = shopware_sdk.TotalCountModeExact
+
+	result, err := searchEntities(ctx, d.client, d.entity, criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search %s, got error: %s", d.typeName, err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.Diagnostics.AddError("Not Found", fmt.Sprintf("No %s matches the given attributes.", d.typeName))
+		return
+	}
+
+	if result.Total > 1 {
+		ids := make([]string, 0)
+
+		for _, entity := range result.Data {
+			id, _ := entity["id"].(string)
+			ids = append(ids, id)
+		}
+
+		sort.Strings(ids)
+
+		resp.Diagnostics.AddError(
+			"Ambiguous Result",
+			fmt.Sprintf("%d entities of %s match the given attributes (e.g. %s), add more attributes or use the id.", result.Total, d.typeName, strings.Join(ids, ", ")),
+		)
+
+		return
+	}
+
+	entity := result.Data[0]
+
+	// Configured filters are kept as given, the database might have matched them case-insensitively.
+	for _, field := range fields {
+		value, ok := configured[field.attribute]
+
+		if !ok {
+			value = lookupFilterValue(lookupFieldValue(entity, field.field))
+		}
+
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(field.attribute), value)...)
+	}
+
+	for _, output := range d.outputs {
+		value := lookupFieldValue(entity, output.field)
+
+		switch output.kind {
+		case lookupOutputNumber:
+			number, _ := value.(float64)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(output.attribute), types.Float64Value(number))...)
+		case lookupOutputBool:
+			flag, _ := value.(bool)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(output.attribute), types.BoolValue(flag))...)
+		default:
+			text, _ := value.(string)
+			resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(output.attribute), stringOrNull(text))...)
+		}
+	}
+}
+
+func lookupOutputFields(outputs []lookupOutput) []lookupField {
+	fields := make([]lookupField, 0, len(outputs))
+
+	for _, output := range outputs {
+		fields = append(fields, output.lookupField)
+	}
+
+	return fields
+}
+
+// lookupAssociations adds the association path of a dotted field, e.g. locale for locale.code.
+func lookupAssociations(associations map[string]shopware_sdk.Criteria, field string) map[string]shopware_sdk.Criteria {
+	association, rest, found := strings.Cut(field, ".")
+
+	if !found {
+		return associations
+	}
+
+	if associations == nil {
+		associations = map[string]shopware_sdk.Criteria{}
+	}
+
+	nested := associations[association]
+	nested.Associations = lookupAssociations(nested.Associations, rest)
+	associations[association] = nested
+
+	return associations
+}
+
+// lookupFilterValue maps the API value of a filter field to its string attribute, numbers like tax rates included.
+func lookupFilterValue(value interface{}) types.String {
+	switch typed := value.(type) {
+	case string:
+		return stringOrNull(typed)
+	case float64:
+		return types.StringValue(strconv.FormatFloat(typed, 'f', -1, 64))
+	default:
+		return types.StringNull()
+	}
+}
+
+// lookupFieldValue resolves a dotted field like locale.code, translatable fields fall back to the translated value.
+func lookupFieldValue(entity map[string]interface{}, field string) interface{} {
+	var current interface{} = entity
+
+	for _, part := range strings.Split(field, ".") {
+		object, ok := current.(map[string]interface{})
+
+		if !ok {
+			return nil
+		}
+
+		value := object[part]
+
+		if value == nil {
+			if translated, ok := object["translated"].(map[string]interface{}); ok {
+				value = translated[part]
+			}
+		}
+
+		current = value
+	}
+
+	return current
+}
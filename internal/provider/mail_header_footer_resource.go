@@ -0,0 +1,311 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &MailHeaderFooterResource{}
+var _ resource.ResourceWithImportState = &MailHeaderFooterResource{}
+
+func NewMailHeaderFooterResource() resource.Resource {
+	return &MailHeaderFooterResource{}
+}
+
+// MailHeaderFooterResource defines the resource implementation.
+type MailHeaderFooterResource struct {
+	client *shopware_sdk.Client
+}
+
+// MailHeaderFooterModel describes the resource data model.
+type MailHeaderFooterModel struct {
+	Id              types.String `tfsdk:"id"`
+	Name            types.String `tfsdk:"name"`
+	Description     types.String `tfsdk:"description"`
+	SystemDefault   types.Bool   `tfsdk:"system_default"`
+	HeaderHtml      types.String `tfsdk:"header_html"`
+	HeaderPlain     types.String `tfsdk:"header_plain"`
+	FooterHtml      types.String `tfsdk:"footer_html"`
+	FooterPlain     types.String `tfsdk:"footer_plain"`
+	SalesChannelIds types.Set    `tfsdk:"sales_channel_ids"`
+	Translations    types.Map    `tfsdk:"translations"`
+}
+
+var mailHeaderFooterTranslations = translationDefinition{
+	entity:     "mail_header_footer",
+	foreignKey: "mailHeaderFooterId",
+	fields: map[string]string{
+		"name":         "name",
+		"description":  "description",
+		"header_html":  "headerHtml",
+		"header_plain": "headerPlain",
+		"footer_html":  "footerHtml",
+		"footer_plain": "footerPlain",
+	},
+	hashed: []string{"header_html", "header_plain", "footer_html", "footer_plain"},
+}
+
+func (r *MailHeaderFooterResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_header_footer"
+}
+
+func (r *MailHeaderFooterResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mail Header and Footer, wrapped around the mail templates of its sales channels. The configured content is stored in the state in full, only content changed outside of Terraform shows up as its `sha256:` hash instead of the full body.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"system_default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Use for sales channels without a header and footer",
+			},
+			"header_html": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "HTML header",
+			},
+			"header_plain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Plain text header",
+			},
+			"footer_html": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "HTML footer",
+			},
+			"footer_plain": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Plain text footer",
+			},
+			"sales_channel_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Sales Channel IDs using the header and footer, sales channels assigned outside of Terraform are kept",
+			},
+			"translations": mailHeaderFooterTranslations.attribute(),
+		},
+	}
+}
+
+func (r *MailHeaderFooterResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MailHeaderFooterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailHeaderFooterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data, MailHeaderFooterModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mail header footer, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailHeaderFooterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailHeaderFooterModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"salesChannels": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "mail_header_footer", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail header footer, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	description, _ := entity["description"].(string)
+	systemDefault, _ := entity["systemDefault"].(bool)
+	headerHtml, _ := entity["headerHtml"].(string)
+	headerPlain, _ := entity["headerPlain"].(string)
+	footerHtml, _ := entity["footerHtml"].(string)
+	footerPlain, _ := entity["footerPlain"].(string)
+
+	data.Name = types.StringValue(name)
+	data.Description = stringOrNull(description)
+	data.SystemDefault = types.BoolValue(systemDefault)
+	data.HeaderHtml = hashedStringValue(headerHtml, data.HeaderHtml)
+	data.HeaderPlain = hashedStringValue(headerPlain, data.HeaderPlain)
+	data.FooterHtml = hashedStringValue(footerHtml, data.FooterHtml)
+	data.FooterPlain = hashedStringValue(footerPlain, data.FooterPlain)
+
+	// Sales channels switched to this header and footer in the Administration keep it.
+	if !data.SalesChannelIds.IsNull() {
+		assigned := associationIds(entity["salesChannels"], "id")
+		data.SalesChannelIds = stringSetValue(stringSliceIntersect(stringSetElements(data.SalesChannelIds), assigned))
+	}
+
+	translations, err := mailHeaderFooterTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail header footer translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailHeaderFooterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailHeaderFooterModel
+	var state MailHeaderFooterModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update mail header footer, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailHeaderFooterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailHeaderFooterModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "mail_header_footer", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mail header footer, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the header and footer. Sales channels reference it, so they are assigned and unassigned on the
+// sales channel itself, only the sales channels dropped since the prior state and still using it are unassigned.
+func (r *MailHeaderFooterResource) upsertData(ctx context.Context, data MailHeaderFooterModel, prior MailHeaderFooterModel) error {
+	mailHeaderFooterId := data.Id.ValueString()
+
+	translations, err := mailHeaderFooterTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":            mailHeaderFooterId,
+		"name":          data.Name.ValueString(),
+		"description":   optionalString(data.Description),
+		"systemDefault": data.SystemDefault.ValueBool(),
+		"headerHtml":    optionalString(data.HeaderHtml),
+		"headerPlain":   optionalString(data.HeaderPlain),
+		"footerHtml":    optionalString(data.FooterHtml),
+		"footerPlain":   optionalString(data.FooterPlain),
+		"translations":  translations,
+	}
+
+	if err := syncUpsert(ctx, r.client, "mail_header_footer", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	salesChannels := make([]map[string]interface{}, 0)
+
+	for _, id := range stringSetElements(data.SalesChannelIds) {
+		salesChannels = append(salesChannels, map[string]interface{}{"id": id, "mailHeaderFooterId": mailHeaderFooterId})
+	}
+
+	removed := stringSliceDiff(stringSetElements(prior.SalesChannelIds), stringSetElements(data.SalesChannelIds))
+
+	if len(removed) > 0 {
+		result, err := searchEntities(ctx, r.client, "sales_channel", shopware_sdk.Criteria{IDs: removed})
+
+		if err != nil {
+			return err
+		}
+
+		// Sales channels switched to another header and footer in the meantime keep it.
+		for _, salesChannel := range result.Data {
+			if assigned, _ := salesChannel["mailHeaderFooterId"].(string); assigned == mailHeaderFooterId {
+				salesChannels = append(salesChannels, map[string]interface{}{"id": salesChannel["id"], "mailHeaderFooterId": nil})
+			}
+		}
+	}
+
+	if len(salesChannels) > 0 {
+		if err := syncUpsert(ctx, r.client, "sales_channel", salesChannels); err != nil {
+			return err
+		}
+	}
+
+	return mailHeaderFooterTranslations.deleteRemoved(ctx, r.client, mailHeaderFooterId, prior.Translations, data.Translations)
+}
+
+func (r *MailHeaderFooterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
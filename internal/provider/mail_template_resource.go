@@ -0,0 +1,480 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &MailTemplateResource{}
+var _ resource.ResourceWithImportState = &MailTemplateResource{}
+
+// systemLanguageId is the ID of the language all entities have to be translated into.
+const systemLanguageId = "2fbb5fe2e29a4d70aa5854ce7ce3e20b"
+
+func NewMailTemplateResource() resource.Resource {
+	return &MailTemplateResource{}
+}
+
+// MailTemplateResource defines the resource implementation.
+type MailTemplateResource struct {
+	client *shopware_sdk.Client
+}
+
+// MailTemplateModel describes the resource data model.
+type MailTemplateModel struct {
+	Id               types.String             `tfsdk:"id"`
+	MailTemplateType types.String             `tfsdk:"mail_template_type"`
+	Description      types.String             `tfsdk:"description"`
+	SenderName       types.String             `tfsdk:"sender_name"`
+	Subject          types.String             `tfsdk:"subject"`
+	ContentHtml      types.String             `tfsdk:"content_html"`
+	ContentPlain     types.String             `tfsdk:"content_plain"`
+	Media            []MailTemplateMediaModel `tfsdk:"media"`
+	CustomFields     types.String             `tfsdk:"custom_fields"`
+	Translations     types.Map                `tfsdk:"translations"`
+}
+
+// MailTemplateMediaModel describes an attachment of the template in one language.
+type MailTemplateMediaModel struct {
+	MediaId  types.String `tfsdk:"media_id"`
+	Language types.String `tfsdk:"language"`
+	Position types.Int64  `tfsdk:"position"`
+}
+
+var mailTemplateTranslations = translationDefinition{
+	entity:     "mail_template",
+	foreignKey: "mailTemplateId",
+	fields: map[string]string{
+		"description":   "description",
+		"sender_name":   "senderName",
+		"subject":       "subject",
+		"content_html":  "contentHtml",
+		"content_plain": "contentPlain",
+	},
+	hashed: []string{"content_html", "content_plain"},
+}
+
+func (r *MailTemplateResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mail_template"
+}
+
+func (r *MailTemplateResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Mail Template. The content is best loaded with `file()` or `templatefile()`. The configured content is stored in the state in full, only content changed outside of Terraform shows up as its `sha256:` hash instead of the full body.",
+
+		Blocks: map[string]schema.Block{
+			"media": schema.SetNestedBlock{
+				MarkdownDescription: "Attachments. Attachments which are not listed are left untouched.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"media_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Media ID",
+						},
+						"language": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							MarkdownDescription: "Language ID or ISO locale of the mails the attachment is sent with, the system language when omitted",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"position": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(0),
+							MarkdownDescription: "Position",
+						},
+					},
+				},
+			},
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"mail_template_type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Technical name of the mail template type, e.g. `order_confirmation_mail`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"sender_name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sender name, may contain Twig",
+			},
+			"subject": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Subject, may contain Twig",
+			},
+			"content_html": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "HTML content",
+			},
+			"content_plain": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Plain text content",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": mailTemplateTranslations.attribute(),
+		},
+	}
+}
+
+func (r *MailTemplateResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *MailTemplateResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data MailTemplateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, &data, MailTemplateModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create mail template, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailTemplateResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data MailTemplateModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"mailTemplateType": {}, "media": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "mail_template", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail template, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	mailTemplateType, _ := entity["mailTemplateType"].(map[string]interface{})
+	technicalName, _ := mailTemplateType["technicalName"].(string)
+	description, _ := entity["description"].(string)
+	senderName, _ := entity["senderName"].(string)
+	subject, _ := entity["subject"].(string)
+	contentHtml, _ := entity["contentHtml"].(string)
+	contentPlain, _ := entity["contentPlain"].(string)
+
+	data.MailTemplateType = types.StringValue(technicalName)
+	data.Description = stringOrNull(description)
+	data.SenderName = stringOrNull(senderName)
+	data.Subject = types.StringValue(subject)
+	data.ContentHtml = hashedStringValue(contentHtml, data.ContentHtml)
+	data.ContentPlain = hashedStringValue(contentPlain, data.ContentPlain)
+
+	media, err := r.readMedia(ctx, entity["media"], data.Media)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail template media, got error: %s", err))
+		return
+	}
+
+	data.Media = media
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail template custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := mailTemplateTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read mail template translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailTemplateResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data MailTemplateModel
+	var state MailTemplateModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, &data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update mail template, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *MailTemplateResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data MailTemplateModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "mail_template", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete mail template, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the template and its listed attachments, only the attachments dropped from the configuration
+// since the prior state are removed.
+func (r *MailTemplateResource) upsertData(ctx context.Context, data *MailTemplateModel, prior MailTemplateModel) error {
+	mailTemplateId := data.Id.ValueString()
+
+	mailTemplateTypeId, err := searchIdByField(ctx, r.client, "mail_template_type", "technicalName", data.MailTemplateType.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	if mailTemplateTypeId == "" {
+		return fmt.Errorf("no mail template type found for %s", data.MailTemplateType.ValueString())
+	}
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := mailTemplateTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	for i := range data.Media {
+		if data.Media[i].Language.IsNull() || data.Media[i].Language.IsUnknown() {
+			data.Media[i].Language = types.StringValue(systemLanguageId)
+		}
+	}
+
+	languageIds, err := resolveLanguageIds(ctx, r.client, append(mailTemplateMediaLanguages(data.Media), mailTemplateMediaLanguages(prior.Media)...))
+
+	if err != nil {
+		return err
+	}
+
+	existing, err := r.existingMedia(ctx, mailTemplateId)
+
+	if err != nil {
+		return err
+	}
+
+	media := make([]map[string]interface{}, 0)
+	planned := make([]string, 0)
+
+	for _, attachment := range data.Media {
+		key := languageIds[attachment.Language.ValueString()] + attachment.MediaId.ValueString()
+		id, ok := existing[key]
+
+		if !ok {
+			id = internal.NewUuid()
+		}
+
+		planned = append(planned, key)
+		media = append(media, map[string]interface{}{
+			"id":         id,
+			"languageId": languageIds[attachment.Language.ValueString()],
+			"mediaId":    attachment.MediaId.ValueString(),
+			"position":   attachment.Position.ValueInt64(),
+		})
+	}
+
+	payload := map[string]interface{}{
+		"id":                 mailTemplateId,
+		"mailTemplateTypeId": mailTemplateTypeId,
+		"description":        optionalString(data.Description),
+		"senderName":         optionalString(data.SenderName),
+		"subject":            data.Subject.ValueString(),
+		"contentHtml":        data.ContentHtml.ValueString(),
+		"contentPlain":       data.ContentPlain.ValueString(),
+		"media":              media,
+		"customFields":       customFields,
+		"translations":       translations,
+	}
+
+	if err := syncUpsert(ctx, r.client, "mail_template", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	priorKeys := make([]string, 0)
+
+	for _, attachment := range prior.Media {
+		priorKeys = append(priorKeys, languageIds[attachment.Language.ValueString()]+attachment.MediaId.ValueString())
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, key := range stringSliceDiff(priorKeys, planned) {
+		if id, ok := existing[key]; ok {
+			removed = append(removed, map[string]interface{}{"id": id})
+		}
+	}
+
+	if err := syncDelete(ctx, r.client, "mail_template_media", removed); err != nil {
+		return err
+	}
+
+	return mailTemplateTranslations.deleteRemoved(ctx, r.client, mailTemplateId, prior.Translations, data.Translations)
+}
+
+// existingMedia returns the IDs of the attachments of a template, keyed by language ID and media ID.
+func (r *MailTemplateResource) existingMedia(ctx context.Context, mailTemplateId string) (map[string]string, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "mailTemplateId", Value: mailTemplateId},
+		},
+	}
+
+	result, err := searchEntities(ctx, r.client, "mail_template_media", criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]string{}
+
+	for _, attachment := range result.Data {
+		id, _ := attachment["id"].(string)
+		languageId, _ := attachment["languageId"].(string)
+		mediaId, _ := attachment["mediaId"].(string)
+		ids[languageId+mediaId] = id
+	}
+
+	return ids, nil
+}
+
+// readMedia reads the attachments known in the prior state, keeping the language notation of the configuration.
+func (r *MailTemplateResource) readMedia(ctx context.Context, value interface{}, prior []MailTemplateMediaModel) ([]MailTemplateMediaModel, error) {
+	if len(prior) == 0 {
+		return prior, nil
+	}
+
+	languageIds, err := resolveLanguageIds(ctx, r.client, mailTemplateMediaLanguages(prior))
+
+	if err != nil {
+		return prior, err
+	}
+
+	attachments, _ := value.([]interface{})
+	media := make([]MailTemplateMediaModel, 0)
+
+	for _, known := range prior {
+		for _, element := range attachments {
+			attachment, _ := element.(map[string]interface{})
+			languageId, _ := attachment["languageId"].(string)
+			mediaId, _ := attachment["mediaId"].(string)
+
+			if languageId != languageIds[known.Language.ValueString()] || mediaId != known.MediaId.ValueString() {
+				continue
+			}
+
+			position, _ := attachment["position"].(float64)
+
+			media = append(media, MailTemplateMediaModel{
+				MediaId:  known.MediaId,
+				Language: known.Language,
+				Position: types.Int64Value(int64(position)),
+			})
+		}
+	}
+
+	sort.Slice(media, func(i, j int) bool {
+		return media[i].Language.ValueString()+media[i].MediaId.ValueString() < media[j].Language.ValueString()+media[j].MediaId.ValueString()
+	})
+
+	return media, nil
+}
+
+func (r *MailTemplateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func mailTemplateMediaLanguages(media []MailTemplateMediaModel) []string {
+	languages := make([]string, 0)
+
+	for _, attachment := range media {
+		if !attachment.Language.IsNull() && !attachment.Language.IsUnknown() {
+			languages = append(languages, attachment.Language.ValueString())
+		}
+	}
+
+	return languages
+}
@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &PaymentMethodResource{}
+var _ resource.ResourceWithImportState = &PaymentMethodResource{}
+
+// paymentMethodDefaultHandler is the handler of payment methods without payment provider, like invoice or cash.
+const paymentMethodDefaultHandler = "Shopware\\Core\\Checkout\\Payment\\Cart\\PaymentHandler\\DefaultPayment"
+
+func NewPaymentMethodResource() resource.Resource {
+	return &PaymentMethodResource{}
+}
+
+// PaymentMethodResource defines the resource implementation.
+type PaymentMethodResource struct {
+	client *shopware_sdk.Client
+}
+
+// PaymentMethodModel describes the resource data model.
+type PaymentMethodModel struct {
+	Id                 types.String `tfsdk:"id"`
+	TechnicalName      types.String `tfsdk:"technical_name"`
+	Adopt              types.Bool   `tfsdk:"adopt"`
+	HandlerIdentifier  types.String `tfsdk:"handler_identifier"`
+	Name               types.String `tfsdk:"name"`
+	Description        types.String `tfsdk:"description"`
+	Active             types.Bool   `tfsdk:"active"`
+	Position           types.Int64  `tfsdk:"position"`
+	AfterOrderEnabled  types.Bool   `tfsdk:"after_order_enabled"`
+	AvailabilityRuleId types.String `tfsdk:"availability_rule_id"`
+	MediaId            types.String `tfsdk:"media_id"`
+	PluginId           types.String `tfsdk:"plugin_id"`
+	CustomFields       types.String `tfsdk:"custom_fields"`
+	Translations       types.Map    `tfsdk:"translations"`
+}
+
+var paymentMethodTranslations = translationDefinition{
+	entity:     "payment_method",
+	foreignKey: "paymentMethodId",
+	fields: map[string]string{
+		"name":        "name",
+		"description": "description",
+	},
+}
+
+func (r *PaymentMethodResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_payment_method"
+}
+
+func (r *PaymentMethodResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Payment Method. Payment methods of plugins and apps can be configured with `adopt`, they are taken over by technical name and kept on destroy.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"technical_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Technical name, e.g. `payment_invoice`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"adopt": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Take over the existing payment method with the technical name instead of creating it. It is not deleted on destroy, imported payment methods can be adopted in place.",
+			},
+			"handler_identifier": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Payment handler, defaults to the handler without payment provider for created payment methods and to the current handler of the plugin or app for adopted ones",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active flag",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Position",
+			},
+			"after_order_enabled": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Allow changing to this payment method after the order was placed",
+			},
+			"availability_rule_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Availability Rule ID",
+			},
+			"media_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Media ID of the logo",
+			},
+			"plugin_id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "ID of the plugin providing the payment method",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": paymentMethodTranslations.attribute(),
+		},
+	}
+}
+
+func (r *PaymentMethodResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PaymentMethodResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PaymentMethodModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Adopt.ValueBool() {
+		existing, err := r.findByTechnicalName(ctx, data.TechnicalName.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to adopt payment method, got error: %s", err))
+			return
+		}
+
+		if existing == nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("technical_name"),
+				"Payment Method Not Found",
+				fmt.Sprintf("No payment method with the technical name %s exists, make sure the plugin or app providing it is installed and active.", data.TechnicalName.ValueString()),
+			)
+
+			return
+		}
+
+		data.Id = types.StringValue(existing.Id)
+
+		if data.HandlerIdentifier.IsUnknown() {
+			data.HandlerIdentifier = types.StringValue(existing.HandlerIdentifier)
+		}
+
+		data.PluginId = stringOrNull(existing.PluginId)
+	} else {
+		data.Id = types.StringValue(internal.NewUuid())
+
+		if data.HandlerIdentifier.IsUnknown() {
+			data.HandlerIdentifier = types.StringValue(paymentMethodDefaultHandler)
+		}
+
+		data.PluginId = types.StringNull()
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create payment method, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PaymentMethodResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PaymentMethodModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs: []string{data.Id.ValueString()},
+	}
+	entities, _, err := r.client.Repository.PaymentMethod.Search(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read payment method, got error: %s", err))
+		return
+	}
+
+	if entities.Total == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := entities.Data[0]
+
+	// Imported payment methods are managed like created ones unless adopt is configured.
+	if data.Adopt.IsNull() {
+		data.Adopt = types.BoolValue(false)
+	}
+
+	data.TechnicalName = types.StringValue(entity.TechnicalName)
+	data.HandlerIdentifier = types.StringValue(entity.HandlerIdentifier)
+	data.Name = types.StringValue(entity.Name)
+	data.Description = stringOrNull(entity.Description)
+	data.Active = types.BoolValue(entity.Active)
+	data.Position = types.Int64Value(int64(entity.Position))
+	data.AfterOrderEnabled = types.BoolValue(entity.AfterOrderEnabled)
+	data.AvailabilityRuleId = stringOrNull(entity.AvailabilityRuleId)
+	data.MediaId = stringOrNull(entity.MediaId)
+	data.PluginId = stringOrNull(entity.PluginId)
+
+	customFields, err := jsonValue(entity.CustomFields, data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read payment method custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := paymentMethodTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read payment method translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PaymentMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PaymentMethodModel
+	var state PaymentMethodModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update payment method, got error: %s", err))
+		return
+	}
+
+	if err := paymentMethodTranslations.deleteRemoved(ctx, r.client, data.Id.ValueString(), state.Translations, data.Translations); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update payment method, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PaymentMethodResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PaymentMethodModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Adopted payment methods belong to their plugin or app, they are only removed from the state.
+	if data.Adopt.ValueBool() {
+		return
+	}
+
+	_, err := r.client.Repository.PaymentMethod.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete payment method, got error: %s", err))
+		return
+	}
+}
+
+func (r *PaymentMethodResource) upsertData(ctx context.Context, data PaymentMethodModel) error {
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := paymentMethodTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":                 data.Id.ValueString(),
+		"technicalName":      data.TechnicalName.ValueString(),
+		"handlerIdentifier":  data.HandlerIdentifier.ValueString(),
+		"name":               data.Name.ValueString(),
+		"description":        optionalString(data.Description),
+		"active":             data.Active.ValueBool(),
+		"position":           data.Position.ValueInt64(),
+		"afterOrderEnabled":  data.AfterOrderEnabled.ValueBool(),
+		"availabilityRuleId": optionalString(data.AvailabilityRuleId),
+		"mediaId":            optionalString(data.MediaId),
+		"customFields":       customFields,
+		"translations":       translations,
+	}
+
+	return syncUpsert(ctx, r.client, "payment_method", []map[string]interface{}{payload})
+}
+
+func (r *PaymentMethodResource) findByTechnicalName(ctx context.Context, technicalName string) (*shopware_sdk.PaymentMethod, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "technicalName", Value: technicalName},
+		},
+	}
+
+	entities, _, err := r.client.Repository.PaymentMethod.Search(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(entities.Data) == 0 {
+		return nil, nil
+	}
+
+	return &entities.Data[0], nil
+}
+
+// ImportState accepts the payment method ID or the technical name.
+func (r *PaymentMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuidPattern.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	existing, err := r.findByTechnicalName(ctx, req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find payment method %s, got error: %s", req.ID, err))
+		return
+	}
+
+	if existing == nil {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a payment method ID or an existing technical name, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), existing.Id)...)
+}
@@ -0,0 +1,101 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+)
+
+// PriceModel describes a price in one currency, as used by the price fields of the DAL.
+type PriceModel struct {
+	CurrencyId types.String  `tfsdk:"currency_id"`
+	Gross      types.Float64 `tfsdk:"gross"`
+	Net        types.Float64 `tfsdk:"net"`
+	Linked     types.Bool    `tfsdk:"linked"`
+}
+
+func priceBlock(description string) schema.SetNestedBlock {
+	return schema.SetNestedBlock{
+		MarkdownDescription: description,
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"currency_id": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Currency ID",
+				},
+				"gross": schema.Float64Attribute{
+					Required:            true,
+					MarkdownDescription: "Gross price",
+				},
+				"net": schema.Float64Attribute{
+					Required:            true,
+					MarkdownDescription: "Net price",
+				},
+				"linked": schema.BoolAttribute{
+					Optional:            true,
+					Computed:            true,
+					Default:             booldefault.StaticBool(false),
+					MarkdownDescription: "Calculate the net price from the gross price",
+				},
+			},
+		},
+	}
+}
+
+func pricePayload(prices []PriceModel) []map[string]interface{} {
+	payload := make([]map[string]interface{}, 0)
+
+	for _, price := range prices {
+		payload = append(payload, map[string]interface{}{
+			"currencyId": price.CurrencyId.ValueString(),
+			"gross":      price.Gross.ValueFloat64(),
+			"net":        price.Net.ValueFloat64(),
+			"linked":     price.Linked.ValueBool(),
+		})
+	}
+
+	return payload
+}
+
+// pricesFromApi reads a price field, which is returned either as list or keyed by c<currencyId>.
+func pricesFromApi(value interface{}) []PriceModel {
+	entries := make([]interface{}, 0)
+
+	switch typed := value.(type) {
+	case []interface{}:
+		entries = typed
+	case map[string]interface{}:
+		for _, entry := range typed {
+			entries = append(entries, entry)
+		}
+	}
+
+	prices := make([]PriceModel, 0)
+
+	for _, entry := range entries {
+		price, ok := entry.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		currencyId, _ := price["currencyId"].(string)
+		gross, _ := price["gross"].(float64)
+		net, _ := price["net"].(float64)
+		linked, _ := price["linked"].(bool)
+
+		prices = append(prices, PriceModel{
+			CurrencyId: types.StringValue(currencyId),
+			Gross:      types.Float64Value(gross),
+			Net:        types.Float64Value(net),
+			Linked:     types.BoolValue(linked),
+		})
+	}
+
+	sort.Slice(prices, func(i, j int) bool {
+		return prices[i].CurrencyId.ValueString() < prices[j].CurrencyId.ValueString()
+	})
+
+	return prices
+}
@@ -0,0 +1,820 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &ProductResource{}
+var _ resource.ResourceWithImportState = &ProductResource{}
+var _ resource.ResourceWithValidateConfig = &ProductResource{}
+
+func NewProductResource() resource.Resource {
+	return &ProductResource{}
+}
+
+// ProductResource defines the resource implementation.
+type ProductResource struct {
+	client *shopware_sdk.Client
+}
+
+// ProductModel describes the resource data model.
+type ProductModel struct {
+	Id                    types.String             `tfsdk:"id"`
+	ParentId              types.String             `tfsdk:"parent_id"`
+	ProductNumber         types.String             `tfsdk:"product_number"`
+	Name                  types.String             `tfsdk:"name"`
+	Description           types.String             `tfsdk:"description"`
+	Active                types.Bool               `tfsdk:"active"`
+	Stock                 types.Int64              `tfsdk:"stock"`
+	TaxId                 types.String             `tfsdk:"tax_id"`
+	ManufacturerId        types.String             `tfsdk:"manufacturer_id"`
+	DeliveryTimeId        types.String             `tfsdk:"delivery_time_id"`
+	IsCloseout            types.Bool               `tfsdk:"is_closeout"`
+	ShippingFree          types.Bool               `tfsdk:"shipping_free"`
+	CoverMediaId          types.String             `tfsdk:"cover_media_id"`
+	CategoryIds           types.Set                `tfsdk:"category_ids"`
+	PropertyIds           types.Set                `tfsdk:"property_ids"`
+	OptionIds             types.Set                `tfsdk:"option_ids"`
+	ConfiguratorOptionIds types.Set                `tfsdk:"configurator_option_ids"`
+	CustomFields          types.String             `tfsdk:"custom_fields"`
+	Translations          types.Map                `tfsdk:"translations"`
+	Price                 []PriceModel             `tfsdk:"price"`
+	Prices                []ProductPriceModel      `tfsdk:"prices"`
+	Visibilities          []ProductVisibilityModel `tfsdk:"visibilities"`
+}
+
+// ProductPriceModel describes an advanced price, which applies when its rule matches.
+type ProductPriceModel struct {
+	RuleId        types.String `tfsdk:"rule_id"`
+	QuantityStart types.Int64  `tfsdk:"quantity_start"`
+	QuantityEnd   types.Int64  `tfsdk:"quantity_end"`
+	CurrencyPrice []PriceModel `tfsdk:"currency_prices"`
+}
+
+// ProductVisibilityModel describes in which sales channel the product is shown.
+type ProductVisibilityModel struct {
+	SalesChannelId types.String `tfsdk:"sales_channel_id"`
+	Visibility     types.String `tfsdk:"visibility"`
+}
+
+var productTranslations = translationDefinition{
+	entity:     "product",
+	foreignKey: "productId",
+	fields: map[string]string{
+		"name":             "name",
+		"description":      "description",
+		"meta_title":       "metaTitle",
+		"meta_description": "metaDescription",
+		"keywords":         "keywords",
+	},
+}
+
+// productVisibilities maps the visibility names to the values stored by Shopware.
+var productVisibilities = map[string]float64{
+	"all":    30,
+	"search": 20,
+	"link":   10,
+}
+
+// productAssignment describes a many to many association, only the IDs known to Terraform are removed again.
+type productAssignment struct {
+	association string
+	entity      string
+	foreignKey  string
+	ids         func(model *ProductModel) *types.Set
+}
+
+var productAssignments = []productAssignment{
+	{
+		association: "categories",
+		entity:      "product_category",
+		foreignKey:  "categoryId",
+		ids:         func(model *ProductModel) *types.Set { return &model.CategoryIds },
+	},
+	{
+		association: "properties",
+		entity:      "product_property",
+		foreignKey:  "optionId",
+		ids:         func(model *ProductModel) *types.Set { return &model.PropertyIds },
+	},
+	{
+		association: "options",
+		entity:      "product_option",
+		foreignKey:  "optionId",
+		ids:         func(model *ProductModel) *types.Set { return &model.OptionIds },
+	},
+}
+
+func (r *ProductResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_product"
+}
+
+func (r *ProductResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Product. Variants are products with a `parent_id`, unset fields of a variant are inherited from the parent.",
+
+		Blocks: map[string]schema.Block{
+			"price": priceBlock("Prices per currency, required for products without parent"),
+			"prices": schema.SetNestedBlock{
+				MarkdownDescription: "Advanced prices, which apply when their rule matches",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Rule ID the price is restricted to",
+						},
+						"quantity_start": schema.Int64Attribute{
+							Required:            true,
+							MarkdownDescription: "Start of the quantity range the price applies to",
+						},
+						"quantity_end": schema.Int64Attribute{
+							Optional:            true,
+							MarkdownDescription: "End of the quantity range the price applies to, open when omitted",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"currency_prices": priceBlock("Prices per currency"),
+					},
+				},
+			},
+			"visibilities": schema.SetNestedBlock{
+				MarkdownDescription: "Sales Channels the product is visible in, visibilities added outside of Terraform are kept",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"sales_channel_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Sales Channel ID",
+						},
+						"visibility": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Visibility, one of `all`, `search` (hidden in listings) or `link` (only reachable by direct link)",
+						},
+					},
+				},
+			},
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Parent Product ID, makes this product a variant",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_number": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Product number",
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Name, required for products without parent",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Active flag",
+			},
+			"stock": schema.Int64Attribute{
+				Required:            true,
+				MarkdownDescription: "Stock",
+			},
+			"tax_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Tax ID, required for products without parent",
+			},
+			"manufacturer_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Manufacturer ID",
+			},
+			"delivery_time_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Delivery Time ID",
+			},
+			"is_closeout": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Prevent orders when out of stock",
+			},
+			"shipping_free": schema.BoolAttribute{
+				Optional:            true,
+				MarkdownDescription: "Free shipping",
+			},
+			"cover_media_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Media ID of the cover image, a cover chosen outside of Terraform is kept when omitted",
+			},
+			"category_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Category IDs, categories assigned outside of Terraform are kept",
+			},
+			"property_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Property Group Option IDs, properties assigned outside of Terraform are kept",
+			},
+			"option_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Property Group Option IDs defining a variant",
+			},
+			"configurator_option_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Property Group Option IDs offered by the variant configurator of a parent product",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": productTranslations.attribute(),
+		},
+	}
+}
+
+func (r *ProductResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ProductResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data ProductModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data, ProductModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create product, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data ProductModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs: []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{
+			"prices":               {},
+			"visibilities":         {},
+			"configuratorSettings": {},
+			"cover":                {},
+		},
+	}
+
+	for _, assignment := range productAssignments {
+		criteria.Associations[assignment.association] = shopware_sdk.Criteria{}
+	}
+
+	result, err := searchEntities(ctx, r.client, "product", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	parentId, _ := entity["parentId"].(string)
+	productNumber, _ := entity["productNumber"].(string)
+	name, _ := entity["name"].(string)
+	description, _ := entity["description"].(string)
+	stock, _ := entity["stock"].(float64)
+	taxId, _ := entity["taxId"].(string)
+	manufacturerId, _ := entity["manufacturerId"].(string)
+	deliveryTimeId, _ := entity["deliveryTimeId"].(string)
+
+	data.ParentId = stringOrNull(parentId)
+	data.ProductNumber = types.StringValue(productNumber)
+	data.Name = stringOrNull(name)
+	data.Description = stringOrNull(description)
+	data.Active = boolOrNull(entity["active"], data.Active)
+	data.Stock = types.Int64Value(int64(stock))
+	data.TaxId = stringOrNull(taxId)
+	data.ManufacturerId = stringOrNull(manufacturerId)
+	data.DeliveryTimeId = stringOrNull(deliveryTimeId)
+	data.IsCloseout = boolOrNull(entity["isCloseout"], data.IsCloseout)
+	data.ShippingFree = boolOrNull(entity["shippingFree"], data.ShippingFree)
+	data.Price = pricesFromApi(entity["price"])
+	data.Prices = productPricesFromApi(entity["prices"])
+
+	// Sales channels the product was made visible in through the Administration are left out, e.g. new storefronts.
+	known := map[string]bool{}
+
+	for _, id := range productVisibilitySalesChannelIds(data.Visibilities) {
+		known[id] = true
+	}
+
+	data.Visibilities = make([]ProductVisibilityModel, 0)
+
+	for _, visibility := range productVisibilitiesFromApi(entity["visibilities"]) {
+		if known[visibility.SalesChannelId.ValueString()] {
+			data.Visibilities = append(data.Visibilities, visibility)
+		}
+	}
+
+	// The cover is only managed once it was set by Terraform, just like its removal.
+	if !data.CoverMediaId.IsNull() {
+		data.CoverMediaId = types.StringNull()
+
+		if cover, ok := entity["cover"].(map[string]interface{}); ok {
+			mediaId, _ := cover["mediaId"].(string)
+			data.CoverMediaId = stringOrNull(mediaId)
+		}
+	}
+
+	// Categories, properties and options assigned in the Administration are kept, only configured ones are compared.
+	for _, assignment := range productAssignments {
+		ids := assignment.ids(&data)
+
+		if ids.IsNull() {
+			continue
+		}
+
+		*ids = stringSetValue(stringSliceIntersect(stringSetElements(*ids), associationIds(entity[assignment.association], "id")))
+	}
+
+	if !data.ConfiguratorOptionIds.IsNull() {
+		data.ConfiguratorOptionIds = stringSetValue(associationIds(entity["configuratorSettings"], "optionId"))
+	}
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := productTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read product translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProductResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data ProductModel
+	var state ProductModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update product, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ProductResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data ProductModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "product", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete product, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the product with its prices, visibilities and configurator settings as configured, and removes
+// only the assignments which were dropped from the configuration since the prior state.
+func (r *ProductResource) upsertData(ctx context.Context, data ProductModel, prior ProductModel) error {
+	productId := data.Id.ValueString()
+
+	// Advanced prices are written as a whole, a rule and start quantity may only be used once per product.
+	existingPrices, err := r.childIds(ctx, "product_price", productId, "id")
+
+	if err != nil {
+		return err
+	}
+
+	prices := make([]map[string]interface{}, 0)
+
+	for _, price := range data.Prices {
+		var quantityEnd interface{}
+
+		if !price.QuantityEnd.IsNull() {
+			quantityEnd = price.QuantityEnd.ValueInt64()
+		}
+
+		prices = append(prices, map[string]interface{}{
+			"id":            internal.NewUuid(),
+			"ruleId":        price.RuleId.ValueString(),
+			"quantityStart": price.QuantityStart.ValueInt64(),
+			"quantityEnd":   quantityEnd,
+			"price":         pricePayload(price.CurrencyPrice),
+		})
+	}
+
+	existingVisibilities, err := r.childIds(ctx, "product_visibility", productId, "salesChannelId")
+
+	if err != nil {
+		return err
+	}
+
+	visibilities := make([]map[string]interface{}, 0)
+
+	for _, visibility := range data.Visibilities {
+		value, ok := productVisibilities[visibility.Visibility.ValueString()]
+
+		if !ok {
+			return fmt.Errorf("unknown visibility %s", visibility.Visibility.ValueString())
+		}
+
+		id, ok := existingVisibilities[visibility.SalesChannelId.ValueString()]
+
+		if !ok {
+			id = internal.NewUuid()
+		}
+
+		visibilities = append(visibilities, map[string]interface{}{
+			"id":             id,
+			"salesChannelId": visibility.SalesChannelId.ValueString(),
+			"visibility":     value,
+		})
+	}
+
+	existingSettings, err := r.childIds(ctx, "product_configurator_setting", productId, "optionId")
+
+	if err != nil {
+		return err
+	}
+
+	configuratorSettings := make([]map[string]interface{}, 0)
+
+	for _, optionId := range stringSetElements(data.ConfiguratorOptionIds) {
+		id, ok := existingSettings[optionId]
+
+		if !ok {
+			id = internal.NewUuid()
+		}
+
+		configuratorSettings = append(configuratorSettings, map[string]interface{}{"id": id, "optionId": optionId})
+	}
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := productTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	var price interface{}
+
+	if len(data.Price) > 0 {
+		price = pricePayload(data.Price)
+	}
+
+	payload := map[string]interface{}{
+		"id":             productId,
+		"parentId":       optionalString(data.ParentId),
+		"productNumber":  data.ProductNumber.ValueString(),
+		"name":           optionalString(data.Name),
+		"description":    optionalString(data.Description),
+		"active":         optionalBool(data.Active),
+		"stock":          data.Stock.ValueInt64(),
+		"taxId":          optionalString(data.TaxId),
+		"manufacturerId": optionalString(data.ManufacturerId),
+		"deliveryTimeId": optionalString(data.DeliveryTimeId),
+		"isCloseout":     optionalBool(data.IsCloseout),
+		"shippingFree":   optionalBool(data.ShippingFree),
+		"price":          price,
+		"prices":         prices,
+		"visibilities":   visibilities,
+		"customFields":   customFields,
+		"translations":   translations,
+	}
+
+	if !data.ConfiguratorOptionIds.IsNull() {
+		payload["configuratorSettings"] = configuratorSettings
+	}
+
+	for _, assignment := range productAssignments {
+		references := make([]map[string]interface{}, 0)
+
+		for _, id := range stringSetElements(*assignment.ids(&data)) {
+			references = append(references, map[string]interface{}{"id": id})
+		}
+
+		payload[assignment.association] = references
+	}
+
+	if !data.CoverMediaId.IsNull() {
+		existingMedia, err := r.childIds(ctx, "product_media", productId, "mediaId")
+
+		if err != nil {
+			return err
+		}
+
+		productMediaId, ok := existingMedia[data.CoverMediaId.ValueString()]
+
+		if !ok {
+			productMediaId = internal.NewUuid()
+		}
+
+		payload["media"] = []map[string]interface{}{{"id": productMediaId, "mediaId": data.CoverMediaId.ValueString()}}
+		payload["coverId"] = productMediaId
+	} else if !prior.CoverMediaId.IsNull() {
+		// Only a cover set by Terraform is removed, covers chosen in the Administration are kept.
+		payload["coverId"] = nil
+	}
+
+	err = syncOperations(
+		ctx,
+		r.client,
+		shopware_sdk.SyncOperation{Entity: "product_price", Action: "delete", Payload: productIdPayload(existingPrices)},
+		shopware_sdk.SyncOperation{Entity: "product", Action: "upsert", Payload: []map[string]interface{}{payload}},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	removedVisibilities := make([]map[string]interface{}, 0)
+
+	for _, salesChannelId := range stringSliceDiff(productVisibilitySalesChannelIds(prior.Visibilities), productVisibilitySalesChannelIds(data.Visibilities)) {
+		if id, ok := existingVisibilities[salesChannelId]; ok {
+			removedVisibilities = append(removedVisibilities, map[string]interface{}{"id": id})
+		}
+	}
+
+	if err := syncDelete(ctx, r.client, "product_visibility", removedVisibilities); err != nil {
+		return err
+	}
+
+	if !data.ConfiguratorOptionIds.IsNull() {
+		removedSettings := make([]map[string]interface{}, 0)
+
+		for _, optionId := range stringSliceDiff(mapKeys(existingSettings), stringSetElements(data.ConfiguratorOptionIds)) {
+			removedSettings = append(removedSettings, map[string]interface{}{"id": existingSettings[optionId]})
+		}
+
+		if err := syncDelete(ctx, r.client, "product_configurator_setting", removedSettings); err != nil {
+			return err
+		}
+	}
+
+	for _, assignment := range productAssignments {
+		removed := make([]map[string]interface{}, 0)
+
+		for _, id := range stringSliceDiff(stringSetElements(*assignment.ids(&prior)), stringSetElements(*assignment.ids(&data))) {
+			removed = append(removed, map[string]interface{}{"productId": productId, assignment.foreignKey: id})
+		}
+
+		if err := syncDelete(ctx, r.client, assignment.entity, removed); err != nil {
+			return err
+		}
+	}
+
+	return productTranslations.deleteRemoved(ctx, r.client, productId, prior.Translations, data.Translations)
+}
+
+// childIds returns the ids of the entities belonging to the product by the given field.
+func (r *ProductResource) childIds(ctx context.Context, entity string, productId string, field string) (map[string]string, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "productId", Value: productId},
+		},
+	}
+
+	result, err := searchEntities(ctx, r.client, entity, criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]string{}
+
+	for _, child := range result.Data {
+		id, _ := child["id"].(string)
+		key, _ := child[field].(string)
+		ids[key] = id
+	}
+
+	return ids, nil
+}
+
+func (r *ProductResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ProductModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	visibilities := mapKeys(productVisibilities)
+	sort.Strings(visibilities)
+
+	for _, visibility := range data.Visibilities {
+		validateOneOf(resp, path.Root("visibilities"), "Invalid Visibility", visibility.Visibility, visibilities)
+	}
+
+	// Variants inherit these fields from their parent.
+	if !data.ParentId.IsNull() {
+		return
+	}
+
+	if data.Name.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("name"), "Missing Name", "A name is required for products without parent_id.")
+	}
+
+	if data.TaxId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("tax_id"), "Missing Tax ID", "A tax_id is required for products without parent_id.")
+	}
+
+	if len(data.Price) == 0 {
+		resp.Diagnostics.AddAttributeError(path.Root("price"), "Missing Price", "A price is required for products without parent_id.")
+	}
+}
+
+// ImportState accepts the product ID or the product number.
+func (r *ProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuidPattern.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "productNumber", Value: req.ID},
+		},
+	}
+
+	ids, _, err := r.client.Repository.Product.SearchIds(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find product %s, got error: %s", req.ID, err))
+		return
+	}
+
+	if len(ids.Data) != 1 {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a product ID or an existing product number, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), ids.Data[0])...)
+}
+
+func productPricesFromApi(value interface{}) []ProductPriceModel {
+	entries, _ := value.([]interface{})
+	prices := make([]ProductPriceModel, 0)
+
+	for _, entry := range entries {
+		price, ok := entry.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		ruleId, _ := price["ruleId"].(string)
+		quantityStart, _ := price["quantityStart"].(float64)
+
+		model := ProductPriceModel{
+			RuleId:        types.StringValue(ruleId),
+			QuantityStart: types.Int64Value(int64(quantityStart)),
+			QuantityEnd:   types.Int64Null(),
+			CurrencyPrice: pricesFromApi(price["price"]),
+		}
+
+		if quantityEnd, ok := price["quantityEnd"].(float64); ok {
+			model.QuantityEnd = types.Int64Value(int64(quantityEnd))
+		}
+
+		prices = append(prices, model)
+	}
+
+	return prices
+}
+
+func productVisibilitiesFromApi(value interface{}) []ProductVisibilityModel {
+	entries, _ := value.([]interface{})
+	visibilities := make([]ProductVisibilityModel, 0)
+
+	for _, entry := range entries {
+		visibility, ok := entry.(map[string]interface{})
+
+		if !ok {
+			continue
+		}
+
+		salesChannelId, _ := visibility["salesChannelId"].(string)
+		number, _ := visibility["visibility"].(float64)
+		name := ""
+
+		for key, known := range productVisibilities {
+			if known == number {
+				name = key
+			}
+		}
+
+		visibilities = append(visibilities, ProductVisibilityModel{
+			SalesChannelId: types.StringValue(salesChannelId),
+			Visibility:     types.StringValue(name),
+		})
+	}
+
+	sort.Slice(visibilities, func(i, j int) bool {
+		return visibilities[i].SalesChannelId.ValueString() < visibilities[j].SalesChannelId.ValueString()
+	})
+
+	return visibilities
+}
+
+// productVisibilitySalesChannelIds returns the sales channel ids of the given visibilities.
+func productVisibilitySalesChannelIds(visibilities []ProductVisibilityModel) []string {
+	ids := make([]string, 0, len(visibilities))
+
+	for _, visibility := range visibilities {
+		ids = append(ids, visibility.SalesChannelId.ValueString())
+	}
+
+	return ids
+}
+
+func productIdPayload(ids map[string]string) []map[string]interface{} {
+	payload := make([]map[string]interface{}, 0)
+
+	for _, id := range ids {
+		payload = append(payload, map[string]interface{}{"id": id})
+	}
+
+	return payload
+}
@@ -0,0 +1,307 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &PropertyGroupOptionResource{}
+var _ resource.ResourceWithImportState = &PropertyGroupOptionResource{}
+var _ resource.ResourceWithValidateConfig = &PropertyGroupOptionResource{}
+
+func NewPropertyGroupOptionResource() resource.Resource {
+	return &PropertyGroupOptionResource{}
+}
+
+// PropertyGroupOptionResource defines the resource implementation.
+type PropertyGroupOptionResource struct {
+	client *shopware_sdk.Client
+}
+
+// PropertyGroupOptionModel describes the resource data model.
+type PropertyGroupOptionModel struct {
+	Id           types.String `tfsdk:"id"`
+	GroupId      types.String `tfsdk:"group_id"`
+	Name         types.String `tfsdk:"name"`
+	Position     types.Int64  `tfsdk:"position"`
+	ColorHexCode types.String `tfsdk:"color_hex_code"`
+	MediaId      types.String `tfsdk:"media_id"`
+	CustomFields types.String `tfsdk:"custom_fields"`
+	Translations types.Map    `tfsdk:"translations"`
+}
+
+var propertyGroupOptionTranslations = translationDefinition{
+	entity:     "property_group_option",
+	foreignKey: "propertyGroupOptionId",
+	fields: map[string]string{
+		"name": "name",
+	},
+}
+
+func (r *PropertyGroupOptionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_property_group_option"
+}
+
+func (r *PropertyGroupOptionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Property Group Option, e.g. XL of the Size group. Don't list the option in the `options` of its group as well.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Identifier, generated when omitted. Set it to keep the ID stable across environments.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Property Group ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Position, used by the `position` sorting type of the group",
+			},
+			"color_hex_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Color of the `color` display type, e.g. `#ff0000`",
+			},
+			"media_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Media ID of the `media` display type",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": propertyGroupOptionTranslations.attribute(),
+		},
+	}
+}
+
+func (r *PropertyGroupOptionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PropertyGroupOptionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PropertyGroupOptionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Id.IsNull() || data.Id.IsUnknown() {
+		data.Id = types.StringValue(internal.NewUuid())
+	} else {
+		// An upsert with a fixed ID would silently take over an option which exists already.
+		existing, err := searchEntities(ctx, r.client, "property_group_option", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create property group option, got error: %s", err))
+			return
+		}
+
+		if len(existing.Data) > 0 {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("id"),
+				"Property Group Option Already Exists",
+				fmt.Sprintf("A property group option with the ID %s exists already, import it with terraform import instead.", data.Id.ValueString()),
+			)
+
+			return
+		}
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create property group option, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyGroupOptionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PropertyGroupOptionModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := searchEntities(ctx, r.client, "property_group_option", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read property group option, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	groupId, _ := entity["groupId"].(string)
+	name, _ := entity["name"].(string)
+	position, _ := entity["position"].(float64)
+	colorHexCode, _ := entity["colorHexCode"].(string)
+	mediaId, _ := entity["mediaId"].(string)
+
+	data.GroupId = types.StringValue(groupId)
+	data.Name = types.StringValue(name)
+	data.Position = types.Int64Value(int64(position))
+	data.ColorHexCode = stringOrNull(colorHexCode)
+	data.MediaId = stringOrNull(mediaId)
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read property group option custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := propertyGroupOptionTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read property group option translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyGroupOptionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PropertyGroupOptionModel
+	var state PropertyGroupOptionModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update property group option, got error: %s", err))
+		return
+	}
+
+	if err := propertyGroupOptionTranslations.deleteRemoved(ctx, r.client, data.Id.ValueString(), state.Translations, data.Translations); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update property group option, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyGroupOptionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PropertyGroupOptionModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "property_group_option", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete property group option, got error: %s", err))
+		return
+	}
+}
+
+func (r *PropertyGroupOptionResource) upsertData(ctx context.Context, data PropertyGroupOptionModel) error {
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := propertyGroupOptionTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":           data.Id.ValueString(),
+		"groupId":      data.GroupId.ValueString(),
+		"name":         data.Name.ValueString(),
+		"position":     data.Position.ValueInt64(),
+		"colorHexCode": optionalString(data.ColorHexCode),
+		"mediaId":      optionalString(data.MediaId),
+		"customFields": customFields,
+		"translations": translations,
+	}
+
+	return syncUpsert(ctx, r.client, "property_group_option", []map[string]interface{}{payload})
+}
+
+func (r *PropertyGroupOptionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PropertyGroupOptionModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.Id.IsNull() && !data.Id.IsUnknown() && !uuidPattern.MatchString(data.Id.ValueString()) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("id"),
+			"Invalid ID",
+			fmt.Sprintf("Expected 32 lowercase hex characters, got: %s", data.Id.ValueString()),
+		)
+	}
+
+	validateColorHexCode(resp, path.Root("color_hex_code"), data.ColorHexCode)
+}
+
+func (r *PropertyGroupOptionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,468 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &PropertyGroupResource{}
+var _ resource.ResourceWithImportState = &PropertyGroupResource{}
+var _ resource.ResourceWithValidateConfig = &PropertyGroupResource{}
+
+func NewPropertyGroupResource() resource.Resource {
+	return &PropertyGroupResource{}
+}
+
+// PropertyGroupResource defines the resource implementation.
+type PropertyGroupResource struct {
+	client *shopware_sdk.Client
+}
+
+// PropertyGroupModel describes the resource data model.
+type PropertyGroupModel struct {
+	Id                         types.String                        `tfsdk:"id"`
+	Name                       types.String                        `tfsdk:"name"`
+	Description                types.String                        `tfsdk:"description"`
+	DisplayType                types.String                        `tfsdk:"display_type"`
+	SortingType                types.String                        `tfsdk:"sorting_type"`
+	Filterable                 types.Bool                          `tfsdk:"filterable"`
+	VisibleOnProductDetailPage types.Bool                          `tfsdk:"visible_on_product_detail_page"`
+	Position                   types.Int64                         `tfsdk:"position"`
+	Options                    map[string]PropertyGroupOptionEntry `tfsdk:"options"`
+	CustomFields               types.String                        `tfsdk:"custom_fields"`
+	Translations               types.Map                           `tfsdk:"translations"`
+}
+
+// PropertyGroupOptionEntry describes an option managed by its group, keyed by its name.
+type PropertyGroupOptionEntry struct {
+	Id           types.String `tfsdk:"id"`
+	Position     types.Int64  `tfsdk:"position"`
+	ColorHexCode types.String `tfsdk:"color_hex_code"`
+	MediaId      types.String `tfsdk:"media_id"`
+}
+
+var propertyGroupTranslations = translationDefinition{
+	entity:     "property_group",
+	foreignKey: "propertyGroupId",
+	fields: map[string]string{
+		"name":        "name",
+		"description": "description",
+	},
+}
+
+var propertyGroupDisplayTypes = []string{"text", "select", "media", "color"}
+var propertyGroupSortingTypes = []string{"alphanumeric", "position"}
+
+func (r *PropertyGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_property_group"
+}
+
+func (r *PropertyGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Property Group, e.g. Size or Color, used for variants and filters",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"display_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("text"),
+				MarkdownDescription: "Display type in the storefront, one of `" + strings.Join(propertyGroupDisplayTypes, "`, `") + "`",
+			},
+			"sorting_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("alphanumeric"),
+				MarkdownDescription: "Sorting of the options, one of `" + strings.Join(propertyGroupSortingTypes, "`, `") + "`",
+			},
+			"filterable": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Show the group in the product filters",
+			},
+			"visible_on_product_detail_page": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Show the group on the product detail page",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Position",
+			},
+			"options": schema.MapNestedAttribute{
+				Optional:            true,
+				MarkdownDescription: "Options keyed by their name. Options which are not listed, e.g. those of `shopware_property_group_option`, are left untouched.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							MarkdownDescription: "Option ID, stays the same while the option is listed",
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"position": schema.Int64Attribute{
+							Optional:            true,
+							Computed:            true,
+							Default:             int64default.StaticInt64(1),
+							MarkdownDescription: "Position, used by the `position` sorting type",
+						},
+						"color_hex_code": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Color of the `color` display type, e.g. `#ff0000`",
+						},
+						"media_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Media ID of the `media` display type",
+						},
+					},
+				},
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": propertyGroupTranslations.attribute(),
+		},
+	}
+}
+
+func (r *PropertyGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *PropertyGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data PropertyGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, &data, PropertyGroupModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create property group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data PropertyGroupModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"options": {}},
+	}
+
+	result, err := searchEntities(ctx, r.client, "property_group", criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read property group, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	description, _ := entity["description"].(string)
+	displayType, _ := entity["displayType"].(string)
+	sortingType, _ := entity["sortingType"].(string)
+	filterable, _ := entity["filterable"].(bool)
+	visibleOnProductDetailPage, _ := entity["visibleOnProductDetailPage"].(bool)
+	position, _ := entity["position"].(float64)
+
+	data.Name = types.StringValue(name)
+	data.Description = stringOrNull(description)
+	data.DisplayType = types.StringValue(displayType)
+	data.SortingType = types.StringValue(sortingType)
+	data.Filterable = types.BoolValue(filterable)
+	data.VisibleOnProductDetailPage = types.BoolValue(visibleOnProductDetailPage)
+	data.Position = types.Int64Value(int64(position))
+
+	// Only the options known to Terraform are compared, others might be managed on their own.
+	if data.Options != nil {
+		options := map[string]PropertyGroupOptionEntry{}
+		apiOptions, _ := entity["options"].([]interface{})
+
+		for _, element := range apiOptions {
+			option, _ := element.(map[string]interface{})
+			id, _ := option["id"].(string)
+			optionName, _ := option["name"].(string)
+			known, ok := data.Options[optionName]
+
+			if !ok || known.Id.ValueString() != id {
+				continue
+			}
+
+			optionPosition, _ := option["position"].(float64)
+			colorHexCode, _ := option["colorHexCode"].(string)
+			mediaId, _ := option["mediaId"].(string)
+
+			options[optionName] = PropertyGroupOptionEntry{
+				Id:           types.StringValue(id),
+				Position:     types.Int64Value(int64(optionPosition)),
+				ColorHexCode: stringOrNull(colorHexCode),
+				MediaId:      stringOrNull(mediaId),
+			}
+		}
+
+		data.Options = options
+	}
+
+	customFields, err := jsonValue(entity["customFields"], data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read property group custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := propertyGroupTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read property group translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data PropertyGroupModel
+	var state PropertyGroupModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, &data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update property group, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *PropertyGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data PropertyGroupModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "property_group", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete property group, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the group and its listed options. Options keep the ID of the prior state, or adopt an existing
+// option of the same name, so products referencing them stay valid. Only options dropped since the prior state are
+// removed.
+func (r *PropertyGroupResource) upsertData(ctx context.Context, data *PropertyGroupModel, prior PropertyGroupModel) error {
+	propertyGroupId := data.Id.ValueString()
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := propertyGroupTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	existingIds := map[string]string{}
+
+	if len(data.Options) > 0 {
+		criteria := shopware_sdk.Criteria{
+			Filter: []shopware_sdk.CriteriaFilter{
+				{Type: shopware_sdk.SearchFilterTypeEquals, Field: "groupId", Value: propertyGroupId},
+			},
+		}
+
+		result, err := searchEntities(ctx, r.client, "property_group_option", criteria)
+
+		if err != nil {
+			return err
+		}
+
+		for _, option := range result.Data {
+			id, _ := option["id"].(string)
+			name, _ := option["name"].(string)
+			existingIds[name] = id
+		}
+	}
+
+	names := mapKeys(data.Options)
+	sort.Strings(names)
+
+	options := make([]map[string]interface{}, 0)
+
+	for _, name := range names {
+		option := data.Options[name]
+		id := prior.Options[name].Id.ValueString()
+
+		if id == "" {
+			id = existingIds[name]
+		}
+
+		if id == "" {
+			id = internal.NewUuid()
+		}
+
+		option.Id = types.StringValue(id)
+		data.Options[name] = option
+
+		options = append(options, map[string]interface{}{
+			"id":           id,
+			"name":         name,
+			"position":     option.Position.ValueInt64(),
+			"colorHexCode": optionalString(option.ColorHexCode),
+			"mediaId":      optionalString(option.MediaId),
+		})
+	}
+
+	payload := map[string]interface{}{
+		"id":                         propertyGroupId,
+		"name":                       data.Name.ValueString(),
+		"description":                optionalString(data.Description),
+		"displayType":                data.DisplayType.ValueString(),
+		"sortingType":                data.SortingType.ValueString(),
+		"filterable":                 data.Filterable.ValueBool(),
+		"visibleOnProductDetailPage": data.VisibleOnProductDetailPage.ValueBool(),
+		"position":                   data.Position.ValueInt64(),
+		"options":                    options,
+		"customFields":               customFields,
+		"translations":               translations,
+	}
+
+	if err := syncUpsert(ctx, r.client, "property_group", []map[string]interface{}{payload}); err != nil {
+		return err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, name := range stringSliceDiff(mapKeys(prior.Options), names) {
+		removed = append(removed, map[string]interface{}{"id": prior.Options[name].Id.ValueString()})
+	}
+
+	if err := syncDelete(ctx, r.client, "property_group_option", removed); err != nil {
+		return err
+	}
+
+	return propertyGroupTranslations.deleteRemoved(ctx, r.client, propertyGroupId, prior.Translations, data.Translations)
+}
+
+func (r *PropertyGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data PropertyGroupModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	validateOneOf(resp, path.Root("display_type"), "Invalid Display Type", data.DisplayType, propertyGroupDisplayTypes)
+	validateOneOf(resp, path.Root("sorting_type"), "Invalid Sorting Type", data.SortingType, propertyGroupSortingTypes)
+
+	for name, option := range data.Options {
+		validateColorHexCode(resp, path.Root("options").AtMapKey(name).AtName("color_hex_code"), option.ColorHexCode)
+	}
+}
+
+// ImportState accepts the property group ID or its name.
+func (r *PropertyGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if uuidPattern.MatchString(req.ID) {
+		resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+		return
+	}
+
+	id, err := searchIdByField(ctx, r.client, "property_group", "name", req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find property group %s, got error: %s", req.ID, err))
+		return
+	}
+
+	if id == "" {
+		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Expected a property group ID or an existing name, got: %s", req.ID))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -92,14 +92,47 @@ func (p *ShopwareProvider) Configure(ctx context.Context, req provider.Configure
 
 func (p *ShopwareProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
-		NewSystemConfigResource,
+		NewDeliveryTimeResource,
 		NewShippingMethodResource,
 		NewRuleResource,
+		NewSystemConfigResource,
+		NewSystemConfigBatchResource,
+		NewSalesChannelResource,
+		NewSalesChannelDomainResource,
+		NewEntityResource,
+		NewProductResource,
+		NewCategoryResource,
+		NewPaymentMethodResource,
+		NewTaxResource,
+		NewTaxRuleResource,
+		NewCurrencyResource,
+		NewCurrencyCountryRoundingResource,
+		NewCountryResource,
+		NewCustomerGroupResource,
+		NewCustomFieldSetResource,
+		NewCustomFieldResource,
+		NewPropertyGroupResource,
+		NewPropertyGroupOptionResource,
+		NewMailTemplateResource,
+		NewMailHeaderFooterResource,
+		NewFlowResource,
+		NewWebhookResource,
+		NewIntegrationResource,
 	}
 }
 
 func (p *ShopwareProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
-	return []func() datasource.DataSource{}
+	return []func() datasource.DataSource{
+		NewCurrencyDataSource,
+		NewCountryDataSource,
+		NewLanguageDataSource,
+		NewTaxDataSource,
+		NewCustomerGroupDataSource,
+		NewSalesChannelDataSource,
+		NewPaymentMethodDataSource,
+		NewDeliveryTimeDataSource,
+		NewEntitySearchDataSource,
+	}
 }
 
 func New(version string) func() provider.Provider {
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// RoundingModel describes the cash rounding of a currency, as used for line items and totals.
+type RoundingModel struct {
+	Decimals    types.Int64   `tfsdk:"decimals"`
+	Interval    types.Float64 `tfsdk:"interval"`
+	RoundForNet types.Bool    `tfsdk:"round_for_net"`
+}
+
+var defaultRounding = RoundingModel{
+	Decimals:    types.Int64Value(2),
+	Interval:    types.Float64Value(0.01),
+	RoundForNet: types.BoolValue(true),
+}
+
+func roundingBlock(description string) schema.SingleNestedBlock {
+	return schema.SingleNestedBlock{
+		MarkdownDescription: description + ", two decimals with an interval of 0.01 when omitted",
+		Attributes: map[string]schema.Attribute{
+			"decimals": schema.Int64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Decimals",
+			},
+			"interval": schema.Float64Attribute{
+				Optional:            true,
+				MarkdownDescription: "Rounding interval, e.g. `0.05` for Swiss cash rounding",
+			},
+			"round_for_net": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Round net prices as well",
+			},
+		},
+	}
+}
+
+func roundingPayload(rounding *RoundingModel) map[string]interface{} {
+	if rounding == nil {
+		rounding = &defaultRounding
+	}
+
+	decimals := defaultRounding.Decimals.ValueInt64()
+	interval := defaultRounding.Interval.ValueFloat64()
+
+	if !rounding.Decimals.IsNull() {
+		decimals = rounding.Decimals.ValueInt64()
+	}
+
+	if !rounding.Interval.IsNull() {
+		interval = rounding.Interval.ValueFloat64()
+	}
+
+	return map[string]interface{}{
+		"decimals":    decimals,
+		"interval":    interval,
+		"roundForNet": rounding.RoundForNet.ValueBool(),
+	}
+}
+
+// roundingFromApi reads a rounding field, an omitted block stays omitted while the default rounding is used.
+func roundingFromApi(value interface{}, prior *RoundingModel) *RoundingModel {
+	rounding, _ := value.(map[string]interface{})
+	decimals, _ := rounding["decimals"].(float64)
+	interval, _ := rounding["interval"].(float64)
+	roundForNet, _ := rounding["roundForNet"].(bool)
+
+	current := &RoundingModel{
+		Decimals:    types.Int64Value(int64(decimals)),
+		Interval:    types.Float64Value(interval),
+		RoundForNet: types.BoolValue(roundForNet),
+	}
+
+	isDefault := current.Decimals.Equal(defaultRounding.Decimals) &&
+		current.Interval.Equal(defaultRounding.Interval) &&
+		current.RoundForNet.Equal(defaultRounding.RoundForNet)
+
+	if prior == nil && isDefault {
+		return nil
+	}
+
+	// Omitted attributes of a configured block stay omitted while they have their default.
+	if prior != nil && prior.Decimals.IsNull() && current.Decimals.Equal(defaultRounding.Decimals) {
+		current.Decimals = types.Int64Null()
+	}
+
+	if prior != nil && prior.Interval.IsNull() && current.Interval.Equal(defaultRounding.Interval) {
+		current.Interval = types.Float64Null()
+	}
+
+	return current
+}
@@ -0,0 +1,257 @@
+package provider
+
+import (
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"sort"
+	"terraform-provider-shopware/internal"
+)
+
+// ruleConditionMaxDepth limits how deep condition containers can be nested, Terraform schemas cannot be recursive.
+const ruleConditionMaxDepth = 6
+
+// RuleConditionModel describes a single rule condition together with its children.
+type RuleConditionModel struct {
+	Type       types.String
+	Value      types.String
+	Conditions []RuleConditionModel
+}
+
+func ruleConditionBlock(depth int) schema.ListNestedBlock {
+	blocks := map[string]schema.Block{}
+
+	if depth > 1 {
+		blocks["conditions"] = ruleConditionBlock(depth - 1)
+	}
+
+	return schema.ListNestedBlock{
+		MarkdownDescription: "Conditions, containers like `orContainer` and `andContainer` hold their children in nested `conditions` blocks",
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"type": schema.StringAttribute{
+					Required:            true,
+					MarkdownDescription: "Condition type, e.g. `orContainer`, `andContainer`, `cartCartAmount`, `customerCustomerGroup` or `dayOfWeek`",
+				},
+				"value": schema.StringAttribute{
+					Optional:            true,
+					MarkdownDescription: "JSON encoded condition value, e.g. `jsonencode({ operator = \"=\", customerGroupIds = [\"...\"] })`",
+				},
+			},
+			Blocks: blocks,
+		},
+	}
+}
+
+func ruleConditionObjectType(depth int) types.ObjectType {
+	attributeTypes := map[string]attr.Type{
+		"type":  types.StringType,
+		"value": types.StringType,
+	}
+
+	if depth > 1 {
+		attributeTypes["conditions"] = types.ListType{ElemType: ruleConditionObjectType(depth - 1)}
+	}
+
+	return types.ObjectType{AttrTypes: attributeTypes}
+}
+
+func ruleConditionsFromList(list types.List) []RuleConditionModel {
+	conditions := make([]RuleConditionModel, 0)
+
+	for _, element := range list.Elements() {
+		object, ok := element.(types.Object)
+
+		if !ok {
+			continue
+		}
+
+		attributes := object.Attributes()
+		condition := RuleConditionModel{}
+		condition.Type, _ = attributes["type"].(types.String)
+		condition.Value, _ = attributes["value"].(types.String)
+
+		if children, ok := attributes["conditions"].(types.List); ok {
+			condition.Conditions = ruleConditionsFromList(children)
+		}
+
+		conditions = append(conditions, condition)
+	}
+
+	return conditions
+}
+
+func ruleConditionsToList(conditions []RuleConditionModel, depth int) (types.List, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	objectType := ruleConditionObjectType(depth)
+	elements := make([]attr.Value, 0)
+
+	for _, condition := range conditions {
+		attributes := map[string]attr.Value{
+			"type":  condition.Type,
+			"value": condition.Value,
+		}
+
+		if depth > 1 {
+			children, childDiags := ruleConditionsToList(condition.Conditions, depth-1)
+			diags.Append(childDiags...)
+			attributes["conditions"] = children
+		} else if len(condition.Conditions) > 0 {
+			diags.AddError(
+				"Unsupported Rule Condition Tree",
+				fmt.Sprintf("Rule conditions can be nested at most %d levels deep", ruleConditionMaxDepth),
+			)
+		}
+
+		element, elementDiags := types.ObjectValue(objectType.AttrTypes, attributes)
+		diags.Append(elementDiags...)
+		elements = append(elements, element)
+	}
+
+	list, listDiags := types.ListValue(objectType, elements)
+	diags.Append(listDiags...)
+
+	return list, diags
+}
+
+func validateRuleConditions(conditions []RuleConditionModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for _, condition := range conditions {
+		if !condition.Value.IsNull() && !condition.Value.IsUnknown() {
+			if _, err := internal.DecodeJson(condition.Value.ValueString()); err != nil {
+				diags.AddAttributeError(
+					path.Root("conditions"),
+					"Invalid Condition Value",
+					fmt.Sprintf("The value of condition %s must be valid JSON, got error: %s", condition.Type.ValueString(), err),
+				)
+			}
+		}
+
+		diags.Append(validateRuleConditions(condition.Conditions)...)
+	}
+
+	return diags
+}
+
+// flattenRuleConditions turns the condition tree into the flat list of rule_condition entities the API expects.
+func flattenRuleConditions(ruleId string, parentId string, conditions []RuleConditionModel) ([]shopware_sdk.RuleCondition, error) {
+	flat := make([]shopware_sdk.RuleCondition, 0)
+
+	for position, condition := range conditions {
+		var value interface{}
+
+		if !condition.Value.IsNull() {
+			decoded, err := internal.DecodeJson(condition.Value.ValueString())
+
+			if err != nil {
+				return nil, fmt.Errorf("invalid value of condition %s: %w", condition.Type.ValueString(), err)
+			}
+
+			value = decoded
+		}
+
+		entity := shopware_sdk.RuleCondition{
+			Id:       internal.NewUuid(),
+			RuleId:   ruleId,
+			ParentId: parentId,
+			Position: float64(position),
+			Type:     condition.Type.ValueString(),
+			Value:    value,
+		}
+
+		children, err := flattenRuleConditions(ruleId, entity.Id, condition.Conditions)
+
+		if err != nil {
+			return nil, err
+		}
+
+		flat = append(flat, entity)
+		flat = append(flat, children...)
+	}
+
+	return flat, nil
+}
+
+// buildRuleConditionTree rebuilds the nested condition tree from the flat rule_condition entities.
+func buildRuleConditionTree(entities []shopware_sdk.RuleCondition, parentId string) ([]RuleConditionModel, error) {
+	children := make([]shopware_sdk.RuleCondition, 0)
+
+	for _, entity := range entities {
+		if entity.ParentId == parentId {
+			children = append(children, entity)
+		}
+	}
+
+	// Positions are not unique when conditions were created in the Administration, the id keeps the order stable.
+	sort.Slice(children, func(i, j int) bool {
+		if children[i].Position == children[j].Position {
+			return children[i].Id < children[j].Id
+		}
+
+		return children[i].Position < children[j].Position
+	})
+
+	conditions := make([]RuleConditionModel, 0)
+
+	for _, child := range children {
+		condition := RuleConditionModel{
+			Type:  types.StringValue(child.Type),
+			Value: types.StringNull(),
+		}
+
+		if child.Value != nil {
+			value, err := internal.EncodeJson(child.Value)
+
+			if err != nil {
+				return nil, err
+			}
+
+			condition.Value = types.StringValue(value)
+		}
+
+		nested, err := buildRuleConditionTree(entities, child.Id)
+
+		if err != nil {
+			return nil, err
+		}
+
+		condition.Conditions = nested
+		conditions = append(conditions, condition)
+	}
+
+	return conditions, nil
+}
+
+// keepRuleConditionValues carries over the configured value strings for conditions whose values are semantically
+// unchanged, so differences in JSON formatting or key order do not show up as drift.
+func keepRuleConditionValues(prior []RuleConditionModel, current []RuleConditionModel) {
+	for i := range current {
+		if i >= len(prior) || prior[i].Type.ValueString() != current[i].Type.ValueString() {
+			continue
+		}
+
+		switch {
+		case prior[i].Value.IsNull() && isEmptyJson(current[i].Value):
+			current[i].Value = types.StringNull()
+		case !prior[i].Value.IsNull() && !current[i].Value.IsNull() && internal.JsonEqual(prior[i].Value.ValueString(), current[i].Value.ValueString()):
+			current[i].Value = prior[i].Value
+		}
+
+		keepRuleConditionValues(prior[i].Conditions, current[i].Conditions)
+	}
+}
+
+// isEmptyJson reports whether the value is an empty JSON array or object, which containers store instead of null.
+func isEmptyJson(value types.String) bool {
+	if value.IsNull() {
+		return false
+	}
+
+	return internal.JsonEqual(value.ValueString(), "[]") || internal.JsonEqual(value.ValueString(), "{}")
+}
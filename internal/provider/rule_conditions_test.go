@@ -0,0 +1,178 @@
+package provider
+
+import (
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"testing"
+)
+
+// ruleCondition builds a condition for the tests, an empty value stands for null.
+func ruleCondition(conditionType string, value string, children ...RuleConditionModel) RuleConditionModel {
+	condition := RuleConditionModel{Type: types.StringValue(conditionType), Value: types.StringNull(), Conditions: children}
+
+	if value != "" {
+		condition.Value = types.StringValue(value)
+	}
+
+	return condition
+}
+
+// formatRuleConditions renders a condition tree compactly, so mismatches are readable in the test output.
+func formatRuleConditions(conditions []RuleConditionModel) string {
+	parts := make([]string, 0, len(conditions))
+
+	for _, condition := range conditions {
+		part := condition.Type.ValueString()
+
+		if !condition.Value.IsNull() {
+			part += " " + condition.Value.ValueString()
+		}
+
+		if len(condition.Conditions) > 0 {
+			part += " [" + formatRuleConditions(condition.Conditions) + "]"
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+func TestRuleConditionTreeRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		conditions []RuleConditionModel
+		expected   string
+	}{
+		{
+			name:       "empty",
+			conditions: []RuleConditionModel{},
+			expected:   "",
+		},
+		{
+			name: "flat",
+			conditions: []RuleConditionModel{
+				ruleCondition("customerGroup", `{"operator": "=", "customerGroupIds": ["a"]}`),
+				ruleCondition("alwaysValid", ""),
+			},
+			expected: `customerGroup {"customerGroupIds":["a"],"operator":"="}, alwaysValid`,
+		},
+		{
+			name: "nested containers",
+			conditions: []RuleConditionModel{
+				ruleCondition("orContainer", "",
+					ruleCondition("andContainer", "",
+						ruleCondition("cartCartAmount", `{"operator": "=", "amount": 100}`),
+						ruleCondition("customerBillingCountry", `{"operator": "=", "countryIds": ["b"]}`),
+					),
+					ruleCondition("andContainer", "",
+						ruleCondition("timeRange", `{"fromTime": "08:00", "toTime": "18:00"}`),
+					),
+				),
+			},
+			expected: `orContainer [andContainer [cartCartAmount {"amount":100,"operator":"="}, customerBillingCountry {"countryIds":["b"],"operator":"="}], andContainer [timeRange {"fromTime":"08:00","toTime":"18:00"}]]`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			flat, err := flattenRuleConditions("rule", "", test.conditions)
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			// The API returns the conditions in any order, the tree must not depend on it.
+			for i, j := 0, len(flat)-1; i < j; i, j = i+1, j-1 {
+				flat[i], flat[j] = flat[j], flat[i]
+			}
+
+			tree, err := buildRuleConditionTree(flat, "")
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if actual := formatRuleConditions(tree); actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
+
+func TestFlattenRuleConditionsInvalidValue(t *testing.T) {
+	if _, err := flattenRuleConditions("rule", "", []RuleConditionModel{ruleCondition("customerGroup", "{")}); err == nil {
+		t.Error("expected an error for a value which is not valid JSON")
+	}
+}
+
+func TestBuildRuleConditionTreeOrder(t *testing.T) {
+	// Conditions created in the Administration share their positions, the id decides then.
+	entities := []shopware_sdk.RuleCondition{
+		{Id: "c", Type: "third", Position: 1},
+		{Id: "b", Type: "second", Position: 0},
+		{Id: "a", Type: "first", Position: 0},
+		{Id: "d", Type: "child", ParentId: "b", Position: 0},
+	}
+
+	tree, err := buildRuleConditionTree(entities, "")
+
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if actual, expected := formatRuleConditions(tree), "first, second [child], third"; actual != expected {
+		t.Errorf("expected %s, got %s", expected, actual)
+	}
+}
+
+func TestKeepRuleConditionValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		prior    []RuleConditionModel
+		current  []RuleConditionModel
+		expected string
+	}{
+		{
+			name:     "reformatted value keeps the configured string",
+			prior:    []RuleConditionModel{ruleCondition("cartCartAmount", `{ "operator": ">=", "amount": 100 }`)},
+			current:  []RuleConditionModel{ruleCondition("cartCartAmount", `{"amount":100,"operator":">="}`)},
+			expected: `cartCartAmount { "operator": ">=", "amount": 100 }`,
+		},
+		{
+			name:     "changed value is reported",
+			prior:    []RuleConditionModel{ruleCondition("cartCartAmount", `{"operator": ">=", "amount": 100}`)},
+			current:  []RuleConditionModel{ruleCondition("cartCartAmount", `{"amount":50,"operator":">="}`)},
+			expected: `cartCartAmount {"amount":50,"operator":">="}`,
+		},
+		{
+			name:     "empty container value stays null",
+			prior:    []RuleConditionModel{ruleCondition("andContainer", "", ruleCondition("alwaysValid", ""))},
+			current:  []RuleConditionModel{ruleCondition("andContainer", "[]", ruleCondition("alwaysValid", "{}"))},
+			expected: "andContainer [alwaysValid]",
+		},
+		{
+			name:     "changed type is not carried over",
+			prior:    []RuleConditionModel{ruleCondition("orContainer", "")},
+			current:  []RuleConditionModel{ruleCondition("andContainer", "[]")},
+			expected: "andContainer []",
+		},
+		{
+			name:     "added conditions are kept as read",
+			prior:    []RuleConditionModel{},
+			current:  []RuleConditionModel{ruleCondition("customerGroup", `{"operator":"="}`)},
+			expected: `customerGroup {"operator":"="}`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			keepRuleConditionValues(test.prior, test.current)
+
+			if actual := formatRuleConditions(test.current); actual != test.expected {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
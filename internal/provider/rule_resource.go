@@ -2,7 +2,6 @@ package provider
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -12,13 +11,13 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
-	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"strings"
 	"terraform-provider-shopware/internal"
 )
 
 var _ resource.Resource = &RuleResource{}
 var _ resource.ResourceWithImportState = &RuleResource{}
+var _ resource.ResourceWithValidateConfig = &RuleResource{}
 
 func NewRuleResource() resource.Resource {
 	return &RuleResource{}
@@ -31,11 +30,11 @@ type RuleResource struct {
 
 // RuleModel describes the resource data model.
 type RuleModel struct {
-	Id         types.String       `tfsdk:"id"`
-	Name       types.String       `tfsdk:"name"`
-	Type       types.List         `tfsdk:"type"`
-	Priority   types.Float64      `tfsdk:"priority"`
-	Conditions basetypes.SetValue `tfsdk:"conditions"`
+	Id         types.String  `tfsdk:"id"`
+	Name       types.String  `tfsdk:"name"`
+	Type       types.List    `tfsdk:"type"`
+	Priority   types.Float64 `tfsdk:"priority"`
+	Conditions types.List    `tfsdk:"conditions"`
 }
 
 func (r *RuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,23 +46,7 @@ func (r *RuleResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 		MarkdownDescription: "Rule",
 
 		Blocks: map[string]schema.Block{
-			"conditions": schema.SetNestedBlock{
-				NestedObject: schema.NestedBlockObject{
-					Attributes: map[string]schema.Attribute{
-						"type": schema.StringAttribute{
-							Required:            true,
-							MarkdownDescription: "Type",
-						},
-						"value": schema.ObjectAttribute{
-							Required: true,
-							AttributeTypes: map[string]attr.Type{
-								"operator":      types.StringType,
-								"affiliateCode": types.StringType,
-							},
-						},
-					},
-				},
-			},
+			"conditions": ruleConditionBlock(ruleConditionMaxDepth),
 		},
 
 		Attributes: map[string]schema.Attribute{
@@ -140,7 +123,10 @@ func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	criteria := shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}}
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"conditions": {}},
+	}
 	entities, _, err := r.client.Repository.Rule.Search(shopware_sdk.NewApiContext(ctx), criteria)
 
 	if err != nil {
@@ -158,25 +144,41 @@ func (r *RuleResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	data.Name = types.StringValue(entity.Name)
 	data.Priority = types.Float64Value(entity.Priority)
 
-	modules := entity.ModuleTypes.(map[string]interface{})["types"].([]interface{})
 	tfModules := make([]attr.Value, 0)
 
-	for _, module := range modules {
-		tfModules = append(tfModules, types.StringValue(module.(string)))
+	// Rules created in the Administration without any assignment have no module types at all.
+	if moduleTypes, ok := entity.ModuleTypes.(map[string]interface{}); ok {
+		if modules, ok := moduleTypes["types"].([]interface{}); ok {
+			for _, module := range modules {
+				if name, ok := module.(string); ok {
+					tfModules = append(tfModules, types.StringValue(name))
+				}
+			}
+		}
 	}
 
-	moduleTypes, diag := types.ListValue(types.StringType, tfModules)
+	moduleTypes, diags := types.ListValue(types.StringType, tfModules)
+	resp.Diagnostics.Append(diags...)
 
-	if diag.HasError() {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	data.Type = moduleTypes
-	conditions, diag := basetypes.NewSetValue(types.ObjectValue(), []attr.Value{})
 
-	if diag.HasError() {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read example, got error: %s", err))
+	tree, err := buildRuleConditionTree(entity.Conditions, "")
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read rule conditions, got error: %s", err))
+		return
+	}
+
+	keepRuleConditionValues(ruleConditionsFromList(data.Conditions), tree)
+
+	conditions, diags := ruleConditionsToList(tree, ruleConditionMaxDepth)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
@@ -212,7 +214,7 @@ func (r *RuleResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	_, err := r.client.Repository.ShippingMethod.Delete(
+	_, err := r.client.Repository.Rule.Delete(
 		shopware_sdk.NewApiContext(ctx),
 		[]string{data.Id.ValueString()},
 	)
@@ -230,26 +232,74 @@ func (r *RuleResource) upsertData(ctx context.Context, data RuleModel) error {
 		moduleTypes = append(moduleTypes, strings.ReplaceAll(ruleType.String(), "\"", ""))
 	}
 
-	var conditions []shopware_sdk.RuleCondition
+	conditions, err := flattenRuleConditions(data.Id.ValueString(), "", ruleConditionsFromList(data.Conditions))
 
-	if err := json.Unmarshal([]byte(data.Conditions.String()), &conditions); err != nil {
+	if err != nil {
 		return err
 	}
 
-	_, err := r.client.Repository.Rule.Upsert(
-		shopware_sdk.NewApiContext(ctx),
-		[]shopware_sdk.Rule{
-			{
-				Id:          data.Id.ValueString(),
-				Name:        data.Name.ValueString(),
-				ModuleTypes: map[string]interface{}{"types": moduleTypes},
-				Priority:    data.Priority.ValueFloat64(),
-				Conditions:  conditions,
+	removed, err := r.rootConditions(ctx, data.Id.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	// Conditions are written as a whole, so the previous ones have to go first. Both happen in one transaction, a
+	// rule without conditions would match every cart.
+	return syncOperations(
+		ctx,
+		r.client,
+		shopware_sdk.SyncOperation{Entity: "rule_condition", Action: "delete", Payload: removed},
+		shopware_sdk.SyncOperation{
+			Entity: "rule",
+			Action: "upsert",
+			Payload: []shopware_sdk.Rule{
+				{
+					Id:          data.Id.ValueString(),
+					Name:        data.Name.ValueString(),
+					ModuleTypes: map[string]interface{}{"types": moduleTypes},
+					Priority:    data.Priority.ValueFloat64(),
+					Conditions:  conditions,
+				},
 			},
 		},
 	)
+}
+
+// rootConditions returns the delete payload of the top level conditions, children are removed together with them.
+func (r *RuleResource) rootConditions(ctx context.Context, ruleId string) ([]map[string]interface{}, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "ruleId", Value: ruleId},
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "parentId", Value: nil},
+		},
+	}
+
+	ids, _, err := r.client.Repository.RuleCondition.SearchIds(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, id := range ids.Data {
+		removed = append(removed, map[string]interface{}{"id": id})
+	}
+
+	return removed, nil
+}
+
+func (r *RuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data RuleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	return err
+	resp.Diagnostics.Append(validateRuleConditions(ruleConditionsFromList(data.Conditions))...)
 }
 
 func (r *RuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
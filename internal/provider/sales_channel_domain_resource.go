@@ -0,0 +1,349 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &SalesChannelDomainResource{}
+var _ resource.ResourceWithImportState = &SalesChannelDomainResource{}
+
+func NewSalesChannelDomainResource() resource.Resource {
+	return &SalesChannelDomainResource{}
+}
+
+// SalesChannelDomainResource defines the resource implementation.
+type SalesChannelDomainResource struct {
+	client *shopware_sdk.Client
+}
+
+// SalesChannelDomainResourceModel describes the resource data model.
+type SalesChannelDomainResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	SalesChannelId        types.String `tfsdk:"sales_channel_id"`
+	Url                   types.String `tfsdk:"url"`
+	LanguageId            types.String `tfsdk:"language_id"`
+	CurrencyId            types.String `tfsdk:"currency_id"`
+	SnippetSetId          types.String `tfsdk:"snippet_set_id"`
+	HreflangDefault       types.Bool   `tfsdk:"hreflang_default"`
+	HreflangUseOnlyLocale types.Bool   `tfsdk:"hreflang_use_only_locale"`
+}
+
+func (r *SalesChannelDomainResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sales_channel_domain"
+}
+
+func (r *SalesChannelDomainResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sales Channel Domain. Do not manage the same URL in the `domains` block of `shopware_sales_channel` as well.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sales Channel Domain identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sales_channel_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Sales Channel ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URL, must be unique across the shop",
+			},
+			"language_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Language ID, must be assigned to the sales channel",
+			},
+			"currency_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Currency ID, must be assigned to the sales channel",
+			},
+			"snippet_set_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Snippet Set ID",
+			},
+			"hreflang_default": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Use this domain as hreflang default (`x-default`) of the sales channel",
+			},
+			"hreflang_use_only_locale": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Use only the locale, e.g. `de` instead of `de-DE`, in the hreflang tag",
+			},
+		},
+	}
+}
+
+func (r *SalesChannelDomainResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SalesChannelDomainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SalesChannelDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	resp.Diagnostics.Append(r.validate(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sales channel domain, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SalesChannelDomainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SalesChannelDomainResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"salesChannel": {}},
+	}
+	entities, _, err := r.client.Repository.SalesChannelDomain.Search(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sales channel domain, got error: %s", err))
+		return
+	}
+
+	if entities.Total == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := entities.Data[0]
+
+	data.SalesChannelId = types.StringValue(entity.SalesChannelId)
+	data.Url = types.StringValue(entity.Url)
+	data.LanguageId = types.StringValue(entity.LanguageId)
+	data.CurrencyId = types.StringValue(entity.CurrencyId)
+	data.SnippetSetId = types.StringValue(entity.SnippetSetId)
+	data.HreflangUseOnlyLocale = types.BoolValue(entity.HreflangUseOnlyLocale)
+	data.HreflangDefault = types.BoolValue(entity.SalesChannel != nil && entity.SalesChannel.HreflangDefaultDomainId == entity.Id)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SalesChannelDomainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SalesChannelDomainResourceModel
+	var state SalesChannelDomainResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.validate(ctx, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sales channel domain, got error: %s", err))
+		return
+	}
+
+	if state.HreflangDefault.ValueBool() && !data.HreflangDefault.ValueBool() {
+		if err := r.clearHreflangDefault(ctx, data); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sales channel domain, got error: %s", err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// clearHreflangDefault unsets the hreflang default domain of the sales channel, unless another domain became the
+// default in the meantime.
+func (r *SalesChannelDomainResource) clearHreflangDefault(ctx context.Context, data SalesChannelDomainResourceModel) error {
+	result, err := searchEntities(ctx, r.client, "sales_channel", shopware_sdk.Criteria{IDs: []string{data.SalesChannelId.ValueString()}})
+
+	if err != nil {
+		return err
+	}
+
+	if len(result.Data) == 0 || result.Data[0]["hreflangDefaultDomainId"] != data.Id.ValueString() {
+		return nil
+	}
+
+	return syncUpsert(ctx, r.client, "sales_channel", []map[string]interface{}{
+		{"id": data.SalesChannelId.ValueString(), "hreflangDefaultDomainId": nil},
+	})
+}
+
+func (r *SalesChannelDomainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SalesChannelDomainResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Repository.SalesChannelDomain.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sales channel domain, got error: %s", err))
+		return
+	}
+}
+
+func (r *SalesChannelDomainResource) upsertData(ctx context.Context, data SalesChannelDomainResourceModel) error {
+	err := syncUpsert(ctx, r.client, "sales_channel_domain", []map[string]interface{}{
+		{
+			"id":                    data.Id.ValueString(),
+			"salesChannelId":        data.SalesChannelId.ValueString(),
+			"url":                   data.Url.ValueString(),
+			"languageId":            data.LanguageId.ValueString(),
+			"currencyId":            data.CurrencyId.ValueString(),
+			"snippetSetId":          data.SnippetSetId.ValueString(),
+			"hreflangUseOnlyLocale": data.HreflangUseOnlyLocale.ValueBool(),
+		},
+	})
+
+	if err != nil || !data.HreflangDefault.ValueBool() {
+		return err
+	}
+
+	return syncUpsert(ctx, r.client, "sales_channel", []map[string]interface{}{
+		{
+			"id":                      data.SalesChannelId.ValueString(),
+			"hreflangDefaultDomainId": data.Id.ValueString(),
+		},
+	})
+}
+
+// validate checks the constraints the Admin API would only report as a generic write error.
+func (r *SalesChannelDomainResource) validate(ctx context.Context, data SalesChannelDomainResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	apiContext := shopware_sdk.NewApiContext(ctx)
+
+	domains, _, err := r.client.Repository.SalesChannelDomain.SearchIds(apiContext, shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "url", Value: data.Url.ValueString()},
+		},
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to search sales channel domains, got error: %s", err))
+		return diags
+	}
+
+	for _, id := range domains.Data {
+		if id != data.Id.ValueString() {
+			diags.AddAttributeError(
+				path.Root("url"),
+				"Duplicate Domain URL",
+				fmt.Sprintf("The URL %s is already used by the sales channel domain %s.", data.Url.ValueString(), id),
+			)
+		}
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs: []string{data.SalesChannelId.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{
+			"languages":  {IDs: []string{data.LanguageId.ValueString()}},
+			"currencies": {IDs: []string{data.CurrencyId.ValueString()}},
+		},
+	}
+	salesChannels, _, err := r.client.Repository.SalesChannel.Search(apiContext, criteria)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read sales channel, got error: %s", err))
+		return diags
+	}
+
+	if salesChannels.Total == 0 {
+		diags.AddAttributeError(
+			path.Root("sales_channel_id"),
+			"Unknown Sales Channel",
+			fmt.Sprintf("The sales channel %s does not exist.", data.SalesChannelId.ValueString()),
+		)
+
+		return diags
+	}
+
+	salesChannel := salesChannels.Data[0]
+
+	if len(salesChannel.Languages) == 0 {
+		diags.AddAttributeError(
+			path.Root("language_id"),
+			"Language Not Assigned",
+			fmt.Sprintf("The language %s is not assigned to the sales channel %s, add it to its language_ids first.", data.LanguageId.ValueString(), salesChannel.Name),
+		)
+	}
+
+	if len(salesChannel.Currencies) == 0 {
+		diags.AddAttributeError(
+			path.Root("currency_id"),
+			"Currency Not Assigned",
+			fmt.Sprintf("The currency %s is not assigned to the sales channel %s, add it to its currency_ids first.", data.CurrencyId.ValueString(), salesChannel.Name),
+		)
+	}
+
+	return diags
+}
+
+func (r *SalesChannelDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
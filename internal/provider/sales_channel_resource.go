@@ -0,0 +1,575 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &SalesChannelResource{}
+var _ resource.ResourceWithImportState = &SalesChannelResource{}
+
+func NewSalesChannelResource() resource.Resource {
+	return &SalesChannelResource{}
+}
+
+// SalesChannelResource defines the resource implementation.
+type SalesChannelResource struct {
+	client *shopware_sdk.Client
+}
+
+// SalesChannelModel describes the resource data model.
+type SalesChannelModel struct {
+	Id                   types.String              `tfsdk:"id"`
+	TypeId               types.String              `tfsdk:"type_id"`
+	Name                 types.String              `tfsdk:"name"`
+	AccessKey            types.String              `tfsdk:"access_key"`
+	Active               types.Bool                `tfsdk:"active"`
+	Maintenance          types.Bool                `tfsdk:"maintenance"`
+	CustomerGroupId      types.String              `tfsdk:"customer_group_id"`
+	NavigationCategoryId types.String              `tfsdk:"navigation_category_id"`
+	CurrencyId           types.String              `tfsdk:"currency_id"`
+	LanguageId           types.String              `tfsdk:"language_id"`
+	PaymentMethodId      types.String              `tfsdk:"payment_method_id"`
+	ShippingMethodId     types.String              `tfsdk:"shipping_method_id"`
+	CountryId            types.String              `tfsdk:"country_id"`
+	CurrencyIds          types.Set                 `tfsdk:"currency_ids"`
+	LanguageIds          types.Set                 `tfsdk:"language_ids"`
+	CountryIds           types.Set                 `tfsdk:"country_ids"`
+	PaymentMethodIds     types.Set                 `tfsdk:"payment_method_ids"`
+	ShippingMethodIds    types.Set                 `tfsdk:"shipping_method_ids"`
+	Translations         types.Map                 `tfsdk:"translations"`
+	Domains              []SalesChannelDomainModel `tfsdk:"domains"`
+}
+
+var salesChannelTranslations = translationDefinition{
+	entity:     "sales_channel",
+	foreignKey: "salesChannelId",
+	fields:     map[string]string{"name": "name"},
+}
+
+// SalesChannelDomainModel describes a domain managed together with the sales channel.
+type SalesChannelDomainModel struct {
+	Url          types.String `tfsdk:"url"`
+	LanguageId   types.String `tfsdk:"language_id"`
+	CurrencyId   types.String `tfsdk:"currency_id"`
+	SnippetSetId types.String `tfsdk:"snippet_set_id"`
+}
+
+// salesChannelAssignment describes a many-to-many association of the sales channel.
+type salesChannelAssignment struct {
+	association string
+	entity      string
+	foreignKey  string
+	ids         func(model *SalesChannelModel) *types.Set
+	defaultId   func(model *SalesChannelModel) types.String
+}
+
+var salesChannelAssignments = []salesChannelAssignment{
+	{
+		association: "currencies",
+		entity:      "sales_channel_currency",
+		foreignKey:  "currencyId",
+		ids:         func(model *SalesChannelModel) *types.Set { return &model.CurrencyIds },
+		defaultId:   func(model *SalesChannelModel) types.String { return model.CurrencyId },
+	},
+	{
+		association: "languages",
+		entity:      "sales_channel_language",
+		foreignKey:  "languageId",
+		ids:         func(model *SalesChannelModel) *types.Set { return &model.LanguageIds },
+		defaultId:   func(model *SalesChannelModel) types.String { return model.LanguageId },
+	},
+	{
+		association: "countries",
+		entity:      "sales_channel_country",
+		foreignKey:  "countryId",
+		ids:         func(model *SalesChannelModel) *types.Set { return &model.CountryIds },
+		defaultId:   func(model *SalesChannelModel) types.String { return model.CountryId },
+	},
+	{
+		association: "paymentMethods",
+		entity:      "sales_channel_payment_method",
+		foreignKey:  "paymentMethodId",
+		ids:         func(model *SalesChannelModel) *types.Set { return &model.PaymentMethodIds },
+		defaultId:   func(model *SalesChannelModel) types.String { return model.PaymentMethodId },
+	},
+	{
+		association: "shippingMethods",
+		entity:      "sales_channel_shipping_method",
+		foreignKey:  "shippingMethodId",
+		ids:         func(model *SalesChannelModel) *types.Set { return &model.ShippingMethodIds },
+		defaultId:   func(model *SalesChannelModel) types.String { return model.ShippingMethodId },
+	},
+}
+
+func (r *SalesChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_sales_channel"
+}
+
+func (r *SalesChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sales Channel. Assignments and domains which are not part of the configuration are left untouched.",
+
+		Blocks: map[string]schema.Block{
+			"domains": schema.SetNestedBlock{
+				MarkdownDescription: "Domains, identified by their URL",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "URL",
+						},
+						"language_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Language ID",
+						},
+						"currency_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Currency ID",
+						},
+						"snippet_set_id": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Snippet Set ID",
+						},
+					},
+				},
+			},
+		},
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Sales Channel identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"type_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Sales Channel Type ID, e.g. `8a243080f92e4c719546314b577cf82b` for Storefront",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"access_key": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Store API access key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active flag",
+			},
+			"maintenance": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				MarkdownDescription: "Maintenance mode",
+			},
+			"customer_group_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Customer Group ID",
+			},
+			"navigation_category_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Navigation entry point Category ID",
+			},
+			"currency_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Default Currency ID",
+			},
+			"language_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Default Language ID",
+			},
+			"payment_method_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Default Payment Method ID",
+			},
+			"shipping_method_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Default Shipping Method ID",
+			},
+			"country_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Default Country ID",
+			},
+			"currency_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Assigned Currency IDs, the default currency is always assigned",
+			},
+			"language_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Assigned Language IDs, the default language is always assigned",
+			},
+			"country_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Assigned Country IDs, the default country is always assigned",
+			},
+			"payment_method_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Assigned Payment Method IDs, the default payment method is always assigned",
+			},
+			"shipping_method_ids": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "Assigned Shipping Method IDs, the default shipping method is always assigned",
+			},
+			"translations": salesChannelTranslations.attribute(),
+		},
+	}
+}
+
+func (r *SalesChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SalesChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SalesChannelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+	data.AccessKey = types.StringValue(internal.NewAccessKey("SWSC"))
+
+	if err := r.upsertData(ctx, data, SalesChannelModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create sales channel, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SalesChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SalesChannelModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"domains": {}},
+	}
+
+	for _, assignment := range salesChannelAssignments {
+		criteria.Associations[assignment.association] = shopware_sdk.Criteria{}
+	}
+
+	entities, _, err := r.client.Repository.SalesChannel.Search(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sales channel, got error: %s", err))
+		return
+	}
+
+	if entities.Total == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := entities.Data[0]
+
+	data.TypeId = types.StringValue(entity.TypeId)
+	data.Name = types.StringValue(entity.Name)
+	data.AccessKey = types.StringValue(entity.AccessKey)
+	data.Active = types.BoolValue(entity.Active)
+	data.Maintenance = types.BoolValue(entity.Maintenance)
+	data.CustomerGroupId = types.StringValue(entity.CustomerGroupId)
+	data.NavigationCategoryId = types.StringValue(entity.NavigationCategoryId)
+	data.CurrencyId = types.StringValue(entity.CurrencyId)
+	data.LanguageId = types.StringValue(entity.LanguageId)
+	data.PaymentMethodId = types.StringValue(entity.PaymentMethodId)
+	data.ShippingMethodId = types.StringValue(entity.ShippingMethodId)
+	data.CountryId = types.StringValue(entity.CountryId)
+
+	assigned := salesChannelAssignedIds(entity)
+
+	// Currencies, languages, countries and methods enabled in the Administration stay enabled, only configured ones are compared.
+	for _, assignment := range salesChannelAssignments {
+		ids := assignment.ids(&data)
+
+		if ids.IsNull() {
+			continue
+		}
+
+		*ids = stringSetValue(stringSliceIntersect(stringSetElements(*ids), assigned[assignment.association]))
+	}
+
+	domains := make([]SalesChannelDomainModel, 0)
+
+	for _, known := range data.Domains {
+		for _, domain := range entity.Domains {
+			if domain.Url != known.Url.ValueString() {
+				continue
+			}
+
+			domains = append(domains, SalesChannelDomainModel{
+				Url:          types.StringValue(domain.Url),
+				LanguageId:   types.StringValue(domain.LanguageId),
+				CurrencyId:   types.StringValue(domain.CurrencyId),
+				SnippetSetId: types.StringValue(domain.SnippetSetId),
+			})
+		}
+	}
+
+	data.Domains = domains
+
+	translations, err := salesChannelTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read sales channel translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SalesChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SalesChannelModel
+	var state SalesChannelModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update sales channel, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SalesChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SalesChannelModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Repository.SalesChannel.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete sales channel, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the sales channel and removes only the domains and assignments which were dropped from the
+// configuration since the prior state.
+func (r *SalesChannelResource) upsertData(ctx context.Context, data SalesChannelModel, prior SalesChannelModel) error {
+	salesChannelId := data.Id.ValueString()
+
+	existingDomains, err := r.domainIds(ctx, salesChannelId)
+
+	if err != nil {
+		return err
+	}
+
+	removedDomains := make([]map[string]interface{}, 0)
+
+	for _, url := range stringSliceDiff(salesChannelDomainUrls(prior.Domains), salesChannelDomainUrls(data.Domains)) {
+		if id, ok := existingDomains[url]; ok {
+			removedDomains = append(removedDomains, map[string]interface{}{"id": id})
+		}
+	}
+
+	payload := map[string]interface{}{
+		"id":                   salesChannelId,
+		"typeId":               data.TypeId.ValueString(),
+		"name":                 data.Name.ValueString(),
+		"accessKey":            data.AccessKey.ValueString(),
+		"active":               data.Active.ValueBool(),
+		"maintenance":          data.Maintenance.ValueBool(),
+		"customerGroupId":      data.CustomerGroupId.ValueString(),
+		"navigationCategoryId": data.NavigationCategoryId.ValueString(),
+		"currencyId":           data.CurrencyId.ValueString(),
+		"languageId":           data.LanguageId.ValueString(),
+		"paymentMethodId":      data.PaymentMethodId.ValueString(),
+		"shippingMethodId":     data.ShippingMethodId.ValueString(),
+		"countryId":            data.CountryId.ValueString(),
+	}
+
+	for _, assignment := range salesChannelAssignments {
+		ids := append(stringSetElements(*assignment.ids(&data)), assignment.defaultId(&data).ValueString())
+		references := make([]map[string]interface{}, 0)
+
+		for _, id := range ids {
+			references = append(references, map[string]interface{}{"id": id})
+		}
+
+		payload[assignment.association] = references
+	}
+
+	domains := make([]map[string]interface{}, 0)
+
+	for _, domain := range data.Domains {
+		id, ok := existingDomains[domain.Url.ValueString()]
+
+		if !ok {
+			id = internal.NewUuid()
+		}
+
+		domains = append(domains, map[string]interface{}{
+			"id":           id,
+			"url":          domain.Url.ValueString(),
+			"languageId":   domain.LanguageId.ValueString(),
+			"currencyId":   domain.CurrencyId.ValueString(),
+			"snippetSetId": domain.SnippetSetId.ValueString(),
+		})
+	}
+
+	payload["domains"] = domains
+
+	translations, err := salesChannelTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload["translations"] = translations
+
+	// Removed domains go first, they might still reference a currency or language which is unassigned below.
+	err = syncOperations(
+		ctx,
+		r.client,
+		shopware_sdk.SyncOperation{Entity: "sales_channel_domain", Action: "delete", Payload: removedDomains},
+		shopware_sdk.SyncOperation{Entity: "sales_channel", Action: "upsert", Payload: []map[string]interface{}{payload}},
+	)
+
+	if err != nil {
+		return err
+	}
+
+	for _, assignment := range salesChannelAssignments {
+		configured := append(stringSetElements(*assignment.ids(&data)), assignment.defaultId(&data).ValueString())
+		removed := make([]map[string]interface{}, 0)
+
+		for _, id := range stringSliceDiff(stringSetElements(*assignment.ids(&prior)), configured) {
+			removed = append(removed, map[string]interface{}{"salesChannelId": salesChannelId, assignment.foreignKey: id})
+		}
+
+		if err := syncDelete(ctx, r.client, assignment.entity, removed); err != nil {
+			return err
+		}
+	}
+
+	return salesChannelTranslations.deleteRemoved(ctx, r.client, salesChannelId, prior.Translations, data.Translations)
+}
+
+// domainIds returns the ids of all domains of the sales channel by their URL.
+func (r *SalesChannelResource) domainIds(ctx context.Context, salesChannelId string) (map[string]string, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "salesChannelId", Value: salesChannelId},
+		},
+	}
+
+	domains, _, err := r.client.Repository.SalesChannelDomain.SearchAll(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ids := map[string]string{}
+
+	for _, domain := range domains.Data {
+		ids[domain.Url] = domain.Id
+	}
+
+	return ids, nil
+}
+
+func (r *SalesChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func salesChannelAssignedIds(entity shopware_sdk.SalesChannel) map[string][]string {
+	assigned := map[string][]string{}
+
+	for _, currency := range entity.Currencies {
+		assigned["currencies"] = append(assigned["currencies"], currency.Id)
+	}
+
+	for _, language := range entity.Languages {
+		assigned["languages"] = append(assigned["languages"], language.Id)
+	}
+
+	for _, country := range entity.Countries {
+		assigned["countries"] = append(assigned["countries"], country.Id)
+	}
+
+	for _, paymentMethod := range entity.PaymentMethods {
+		assigned["paymentMethods"] = append(assigned["paymentMethods"], paymentMethod.Id)
+	}
+
+	for _, shippingMethod := range entity.ShippingMethods {
+		assigned["shippingMethods"] = append(assigned["shippingMethods"], shippingMethod.Id)
+	}
+
+	return assigned
+}
+
+func salesChannelDomainUrls(domains []SalesChannelDomainModel) []string {
+	urls := make([]string, 0)
+
+	for _, domain := range domains {
+		urls = append(urls, domain.Url.ValueString())
+	}
+
+	return urls
+}
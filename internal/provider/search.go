@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"context"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"net/http"
+	"strings"
+)
+
+// searchResult is the plain JSON response of the search endpoints, used where no SDK struct fits.
+type searchResult struct {
+	Total int64                    `json:"total"`
+	Data  []map[string]interface{} `json:"data"`
+}
+
+// searchEntities runs a search for any entity, including entities of plugins and apps unknown to the SDK.
+func searchEntities(ctx context.Context, client *shopware_sdk.Client, entity string, criteria interface{}) (*searchResult, error) {
+	apiContext := shopware_sdk.NewApiContext(ctx)
+
+	req, err := client.NewRequest(apiContext, http.MethodPost, "/api/search/"+strings.ReplaceAll(entity, "_", "-"), criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	result := new(searchResult)
+
+	if _, err := client.Do(apiContext.Context, req, result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// searchIdByField returns the ID of the entity with the given field value, or an empty string when none matches.
+func searchIdByField(ctx context.Context, client *shopware_sdk.Client, entity string, field string, value string) (string, error) {
+	criteria := shopware_sdk.Criteria{
+		Limit: 1,
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: field, Value: value},
+		},
+	}
+
+	result, err := searchEntities(ctx, client, entity, criteria)
+
+	if err != nil || len(result.Data) == 0 {
+		return "", err
+	}
+
+	id, _ := result.Data[0]["id"].(string)
+
+	return id, nil
+}
@@ -7,7 +7,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"terraform-provider-shopware/internal"
@@ -15,6 +17,7 @@ import (
 
 var _ resource.Resource = &ShippingMethodResource{}
 var _ resource.ResourceWithImportState = &ShippingMethodResource{}
+var _ resource.ResourceWithValidateConfig = &ShippingMethodResource{}
 
 func NewShippingMethodResource() resource.Resource {
 	return &ShippingMethodResource{}
@@ -27,12 +30,50 @@ type ShippingMethodResource struct {
 
 // ShippingMethodModel describes the resource data model.
 type ShippingMethodModel struct {
-	Id                 types.String `tfsdk:"id"`
-	TechnicalName      types.String `tfsdk:"technical_name"`
-	Name               types.String `tfsdk:"name"`
-	Active             types.Bool   `tfsdk:"active"`
-	DeliveryTimeId     types.String `tfsdk:"delivery_time_id"`
-	AvailabilityRuleId types.String `tfsdk:"availability_rule_id"`
+	Id                 types.String               `tfsdk:"id"`
+	TechnicalName      types.String               `tfsdk:"technical_name"`
+	Name               types.String               `tfsdk:"name"`
+	Active             types.Bool                 `tfsdk:"active"`
+	DeliveryTimeId     types.String               `tfsdk:"delivery_time_id"`
+	AvailabilityRuleId types.String               `tfsdk:"availability_rule_id"`
+	Description        types.String               `tfsdk:"description"`
+	TrackingUrl        types.String               `tfsdk:"tracking_url"`
+	TaxType            types.String               `tfsdk:"tax_type"`
+	TaxId              types.String               `tfsdk:"tax_id"`
+	Position           types.Int64                `tfsdk:"position"`
+	MediaId            types.String               `tfsdk:"media_id"`
+	CustomFields       types.String               `tfsdk:"custom_fields"`
+	Translations       types.Map                  `tfsdk:"translations"`
+	Prices             []ShippingMethodPriceModel `tfsdk:"prices"`
+}
+
+var shippingMethodTranslations = translationDefinition{
+	entity:     "shipping_method",
+	foreignKey: "shippingMethodId",
+	fields: map[string]string{
+		"name":         "name",
+		"description":  "description",
+		"tracking_url": "trackingUrl",
+	},
+}
+
+// ShippingMethodPriceModel describes one row of the price matrix.
+type ShippingMethodPriceModel struct {
+	Calculation   types.String  `tfsdk:"calculation"`
+	QuantityStart types.Float64 `tfsdk:"quantity_start"`
+	QuantityEnd   types.Float64 `tfsdk:"quantity_end"`
+	RuleId        types.String  `tfsdk:"rule_id"`
+	CurrencyPrice []PriceModel  `tfsdk:"currency_prices"`
+}
+
+var shippingMethodTaxTypes = []string{"auto", "highest", "fixed"}
+
+// shippingMethodPriceCalculations maps the calculation types to the values stored by Shopware.
+var shippingMethodPriceCalculations = map[string]float64{
+	"line_item_count": 1,
+	"price":           2,
+	"weight":          3,
+	"volume":          4,
 }
 
 func (r *ShippingMethodResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,6 +84,35 @@ func (r *ShippingMethodResource) Schema(ctx context.Context, req resource.Schema
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Shipping Method",
 
+		Blocks: map[string]schema.Block{
+			"prices": schema.SetNestedBlock{
+				MarkdownDescription: "Price matrix",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"calculation": schema.StringAttribute{
+							Required:            true,
+							MarkdownDescription: "Calculation type, one of `line_item_count`, `price`, `weight` or `volume`",
+						},
+						"quantity_start": schema.Float64Attribute{
+							Required:            true,
+							MarkdownDescription: "Start of the range the price applies to",
+						},
+						"quantity_end": schema.Float64Attribute{
+							Optional:            true,
+							MarkdownDescription: "End of the range the price applies to, open when omitted",
+						},
+						"rule_id": schema.StringAttribute{
+							Optional:            true,
+							MarkdownDescription: "Rule ID the price is restricted to",
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"currency_prices": priceBlock("Prices per currency"),
+					},
+				},
+			},
+		},
+
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -71,6 +141,39 @@ func (r *ShippingMethodResource) Schema(ctx context.Context, req resource.Schema
 				Required:            true,
 				MarkdownDescription: "Availability Rule ID",
 			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Description",
+			},
+			"tracking_url": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Tracking URL, `%s` is replaced with the tracking code",
+			},
+			"tax_type": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("auto"),
+				MarkdownDescription: "Tax calculation, one of `auto`, `highest` or `fixed`",
+			},
+			"tax_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Tax ID, required for the `fixed` tax type",
+			},
+			"position": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(1),
+				MarkdownDescription: "Position",
+			},
+			"media_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Media ID of the logo",
+			},
+			"custom_fields": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "JSON encoded custom fields",
+			},
+			"translations": shippingMethodTranslations.attribute(),
 		},
 	}
 }
@@ -122,7 +225,10 @@ func (r *ShippingMethodResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	criteria := shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}}
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"prices": {}},
+	}
 	entities, _, err := r.client.Repository.ShippingMethod.Search(shopware_sdk.NewApiContext(ctx), criteria)
 
 	if err != nil {
@@ -142,21 +248,53 @@ func (r *ShippingMethodResource) Read(ctx context.Context, req resource.ReadRequ
 	data.Active = types.BoolValue(entity.Active)
 	data.DeliveryTimeId = types.StringValue(entity.DeliveryTimeId)
 	data.AvailabilityRuleId = types.StringValue(entity.AvailabilityRuleId)
+	data.Description = stringOrNull(entity.Description)
+	data.TrackingUrl = stringOrNull(entity.TrackingUrl)
+	data.TaxType = types.StringValue(entity.TaxType)
+	data.TaxId = stringOrNull(entity.TaxId)
+	data.Position = types.Int64Value(int64(entity.Position))
+	data.MediaId = stringOrNull(entity.MediaId)
+	data.Prices = shippingMethodPricesFromApi(entity.Prices)
+
+	customFields, err := jsonValue(entity.CustomFields, data.CustomFields)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shipping method custom fields, got error: %s", err))
+		return
+	}
+
+	data.CustomFields = customFields
+
+	translations, err := shippingMethodTranslations.read(ctx, r.client, data.Id.ValueString(), data.Translations)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read shipping method translations, got error: %s", err))
+		return
+	}
+
+	data.Translations = translations
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
 
 func (r *ShippingMethodResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var data ShippingMethodModel
+	var state ShippingMethodModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
 	if err := r.upsertData(ctx, data); err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update delivery time, got error: %s", err))
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update shipping method, got error: %s", err))
+		return
+	}
+
+	if err := shippingMethodTranslations.deleteRemoved(ctx, r.client, data.Id.ValueString(), state.Translations, data.Translations); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update shipping method, got error: %s", err))
 		return
 	}
 
@@ -185,23 +323,146 @@ func (r *ShippingMethodResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *ShippingMethodResource) upsertData(ctx context.Context, data ShippingMethodModel) error {
-	_, err := r.client.Repository.ShippingMethod.Upsert(
-		shopware_sdk.NewApiContext(ctx),
-		[]shopware_sdk.ShippingMethod{
-			{
-				Id:                 data.Id.ValueString(),
-				Active:             data.Active.ValueBool(),
-				Name:               data.Name.ValueString(),
-				TechnicalName:      data.TechnicalName.ValueString(),
-				DeliveryTimeId:     data.DeliveryTimeId.ValueString(),
-				AvailabilityRuleId: data.AvailabilityRuleId.ValueString(),
-			},
-		},
+	prices := make([]map[string]interface{}, 0)
+
+	for _, price := range data.Prices {
+		calculation, ok := shippingMethodPriceCalculations[price.Calculation.ValueString()]
+
+		if !ok {
+			return fmt.Errorf("unknown price calculation %s", price.Calculation.ValueString())
+		}
+
+		var quantityEnd interface{}
+
+		if !price.QuantityEnd.IsNull() {
+			quantityEnd = price.QuantityEnd.ValueFloat64()
+		}
+
+		prices = append(prices, map[string]interface{}{
+			"id":               internal.NewUuid(),
+			"shippingMethodId": data.Id.ValueString(),
+			"calculation":      calculation,
+			"quantityStart":    price.QuantityStart.ValueFloat64(),
+			"quantityEnd":      quantityEnd,
+			"ruleId":           optionalString(price.RuleId),
+			"currencyPrice":    pricePayload(price.CurrencyPrice),
+		})
+	}
+
+	removed, err := r.existingPrices(ctx, data.Id.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	customFields, err := optionalJson(data.CustomFields)
+
+	if err != nil {
+		return fmt.Errorf("custom fields must be valid JSON: %w", err)
+	}
+
+	translations, err := shippingMethodTranslations.payload(ctx, r.client, data.Translations)
+
+	if err != nil {
+		return err
+	}
+
+	payload := map[string]interface{}{
+		"id":                 data.Id.ValueString(),
+		"active":             data.Active.ValueBool(),
+		"name":               data.Name.ValueString(),
+		"technicalName":      data.TechnicalName.ValueString(),
+		"deliveryTimeId":     data.DeliveryTimeId.ValueString(),
+		"availabilityRuleId": data.AvailabilityRuleId.ValueString(),
+		"description":        optionalString(data.Description),
+		"trackingUrl":        optionalString(data.TrackingUrl),
+		"taxType":            data.TaxType.ValueString(),
+		"taxId":              optionalString(data.TaxId),
+		"position":           data.Position.ValueInt64(),
+		"mediaId":            optionalString(data.MediaId),
+		"customFields":       customFields,
+		"translations":       translations,
+	}
+
+	// The price matrix is written as a whole, a rule and start quantity may only be used once per shipping method.
+	// All of it happens in one transaction, so a failing write never leaves the shipping method without prices.
+	return syncOperations(
+		ctx,
+		r.client,
+		shopware_sdk.SyncOperation{Entity: "shipping_method_price", Action: "delete", Payload: removed},
+		shopware_sdk.SyncOperation{Entity: "shipping_method", Action: "upsert", Payload: []map[string]interface{}{payload}},
+		shopware_sdk.SyncOperation{Entity: "shipping_method_price", Action: "upsert", Payload: prices},
 	)
+}
+
+// existingPrices returns the delete payload of all prices of the shipping method.
+func (r *ShippingMethodResource) existingPrices(ctx context.Context, shippingMethodId string) ([]map[string]interface{}, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "shippingMethodId", Value: shippingMethodId},
+		},
+	}
 
-	return err
+	ids, _, err := r.client.Repository.ShippingMethodPrice.SearchIds(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, id := range ids.Data {
+		removed = append(removed, map[string]interface{}{"id": id})
+	}
+
+	return removed, nil
+}
+
+func (r *ShippingMethodResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data ShippingMethodModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.TaxType.IsUnknown() || data.TaxType.IsNull() {
+		return
+	}
+
+	validateOneOf(resp, path.Root("tax_type"), "Invalid Tax Type", data.TaxType, shippingMethodTaxTypes)
+
+	if data.TaxType.ValueString() == "fixed" && data.TaxId.IsNull() {
+		resp.Diagnostics.AddAttributeError(path.Root("tax_id"), "Missing Tax ID", "A tax_id is required when tax_type is fixed.")
+	}
 }
 
 func (r *ShippingMethodResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
 }
+
+func shippingMethodPricesFromApi(entities []shopware_sdk.ShippingMethodPrice) []ShippingMethodPriceModel {
+	prices := make([]ShippingMethodPriceModel, 0)
+
+	for _, entity := range entities {
+		price := ShippingMethodPriceModel{
+			Calculation:   types.StringNull(),
+			QuantityStart: types.Float64Value(entity.QuantityStart),
+			QuantityEnd:   types.Float64Null(),
+			RuleId:        stringOrNull(entity.RuleId),
+			CurrencyPrice: pricesFromApi(entity.CurrencyPrice),
+		}
+
+		for name, calculation := range shippingMethodPriceCalculations {
+			if calculation == entity.Calculation {
+				price.Calculation = types.StringValue(name)
+			}
+		}
+
+		// The SDK cannot tell an open range from zero, an end of zero is never meaningful though.
+		if entity.QuantityEnd != 0 {
+			price.QuantityEnd = types.Float64Value(entity.QuantityEnd)
+		}
+
+		prices = append(prices, price)
+	}
+
+	return prices
+}
@@ -0,0 +1,57 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+)
+
+// syncUpsert writes plain payloads through the sync API. The generated SDK structs omit false and zero values,
+// so they cannot be used where those have to be written.
+func syncUpsert(ctx context.Context, client *shopware_sdk.Client, entity string, payload []map[string]interface{}) error {
+	return syncOperation(ctx, client, entity, "upsert", payload)
+}
+
+// syncDelete deletes by primary key, mapping entities expect both foreign keys instead of an id.
+func syncDelete(ctx context.Context, client *shopware_sdk.Client, entity string, payload []map[string]interface{}) error {
+	if len(payload) == 0 {
+		return nil
+	}
+
+	return syncOperation(ctx, client, entity, "delete", payload)
+}
+
+func syncOperation(ctx context.Context, client *shopware_sdk.Client, entity string, action string, payload []map[string]interface{}) error {
+	_, err := client.Bulk.Sync(
+		shopware_sdk.NewApiContext(ctx),
+		map[string]shopware_sdk.SyncOperation{
+			entity: {
+				Entity:  entity,
+				Action:  action,
+				Payload: payload,
+			},
+		},
+	)
+
+	return err
+}
+
+// syncOperations sends several operations in one sync request, which Shopware runs in a single transaction, so a
+// failing write never leaves the deletes of the other operations behind. Operations run in the given order, deletes
+// without a payload are skipped.
+func syncOperations(ctx context.Context, client *shopware_sdk.Client, operations ...shopware_sdk.SyncOperation) error {
+	payload := map[string]shopware_sdk.SyncOperation{}
+
+	for i, operation := range operations {
+		if removed, ok := operation.Payload.([]map[string]interface{}); ok && operation.Action == "delete" && len(removed) == 0 {
+			continue
+		}
+
+		// The keys are encoded in sorted order, which is the order Shopware runs the operations in.
+		payload[fmt.Sprintf("%02d-%s-%s", i, operation.Action, operation.Entity)] = operation
+	}
+
+	_, err := client.Bulk.Sync(shopware_sdk.NewApiContext(ctx), payload)
+
+	return err
+}
@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// systemConfigDomain returns the domain of a config key, e.g. core.basicInformation for core.basicInformation.email.
+func systemConfigDomain(key string) string {
+	if index := strings.LastIndex(key, "."); index != -1 {
+		return key[:index]
+	}
+
+	return key
+}
+
+// readSystemConfig fetches the values stored for the domain, values inherited from the global scope are not included.
+func readSystemConfig(ctx shopware_sdk.ApiContext, client *shopware_sdk.Client, domain string, salesChannelId string) (map[string]interface{}, error) {
+	query := url.Values{}
+	query.Set("domain", strings.TrimSuffix(domain, "."))
+
+	if salesChannelId != "" {
+		query.Set("salesChannelId", salesChannelId)
+	}
+
+	req, err := client.NewRequest(ctx, http.MethodGet, "/api/_action/system-config?"+query.Encode(), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	var values interface{}
+
+	if _, err := client.Do(ctx.Context, req, &values); err != nil {
+		return nil, err
+	}
+
+	// An empty domain is returned as an empty JSON array.
+	config, ok := values.(map[string]interface{})
+
+	if !ok {
+		return map[string]interface{}{}, nil
+	}
+
+	return config, nil
+}
+
+// writeSystemConfig stores the values, a nil value removes the key so the inherited or default value applies again.
+func writeSystemConfig(ctx shopware_sdk.ApiContext, client *shopware_sdk.Client, salesChannelId string, values map[string]interface{}) error {
+	scope := "null"
+
+	if salesChannelId != "" {
+		scope = salesChannelId
+	}
+
+	payload, err := json.Marshal(map[string]interface{}{scope: values})
+
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.SystemConfigManager.UpdateConfig(ctx, string(payload))
+
+	if err != nil {
+		return err
+	}
+
+	return resp.Body.Close()
+}
+
+// parseSystemConfigId splits an id of the form [sales_channel_id:]key.
+func parseSystemConfigId(id string) (salesChannelId string, key string, err error) {
+	parts := strings.Split(id, ":")
+
+	switch len(parts) {
+	case 1:
+		return "", parts[0], nil
+	case 2:
+		return parts[0], parts[1], nil
+	default:
+		return "", "", fmt.Errorf("expected id in the format [sales_channel_id:]key, got %s", id)
+	}
+}
+
+func systemConfigId(salesChannelId string, key string) string {
+	if salesChannelId == "" {
+		return key
+	}
+
+	return salesChannelId + ":" + key
+}
@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &SystemConfigBatchResource{}
+var _ resource.ResourceWithImportState = &SystemConfigBatchResource{}
+
+func NewSystemConfigBatchResource() resource.Resource {
+	return &SystemConfigBatchResource{}
+}
+
+// SystemConfigBatchResource defines the resource implementation.
+type SystemConfigBatchResource struct {
+	client *shopware_sdk.Client
+}
+
+// SystemConfigBatchModel describes the resource data model.
+type SystemConfigBatchModel struct {
+	Id             types.String `tfsdk:"id"`
+	Domain         types.String `tfsdk:"domain"`
+	SalesChannelId types.String `tfsdk:"sales_channel_id"`
+	Values         types.Map    `tfsdk:"values"`
+}
+
+func (r *SystemConfigBatchResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_config_batch"
+}
+
+func (r *SystemConfigBatchResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Sets multiple System Config values of one domain in a single request. Only the keys listed in `values` are managed.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier in the format `[sales_channel_id:]domain`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Domain prefix of the keys, e.g. `SwagPayPal.settings.`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sales_channel_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sales Channel ID, the values are set globally when omitted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"values": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				MarkdownDescription: "JSON encoded values by key relative to the domain, e.g. `{ clientId = jsonencode(\"...\") }`",
+			},
+		},
+	}
+}
+
+func (r *SystemConfigBatchResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemConfigBatchResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemConfigBatchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(systemConfigId(data.SalesChannelId.ValueString(), data.Domain.ValueString()))
+
+	if err := r.upsertData(ctx, data, nil); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create system config batch, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemConfigBatchResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemConfigBatchModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, err := readSystemConfig(shopware_sdk.NewApiContext(ctx), r.client, data.Domain.ValueString(), data.SalesChannelId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system config batch, got error: %s", err))
+		return
+	}
+
+	prefix := systemConfigBatchPrefix(data.Domain.ValueString())
+	owned, diags := systemConfigBatchValues(ctx, data.Values)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Without known keys (e.g. after an import) the whole domain is adopted.
+	if data.Values.IsNull() {
+		owned = map[string]string{}
+
+		for key := range values {
+			owned[strings.TrimPrefix(key, prefix)] = ""
+		}
+	}
+
+	current := map[string]attr.Value{}
+
+	for key, prior := range owned {
+		value, ok := values[prefix+key]
+
+		if !ok || value == nil {
+			continue
+		}
+
+		encoded, err := internal.EncodeJson(value)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system config batch, got error: %s", err))
+			return
+		}
+
+		if prior != "" && internal.JsonEqual(prior, encoded) {
+			encoded = prior
+		}
+
+		current[key] = types.StringValue(encoded)
+	}
+
+	data.Values, diags = types.MapValue(types.StringType, current)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemConfigBatchResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemConfigBatchModel
+	var state SystemConfigBatchModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	previous, diags := systemConfigBatchValues(ctx, state.Values)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, previous); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update system config batch, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemConfigBatchResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemConfigBatchModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owned, diags := systemConfigBatchValues(ctx, data.Values)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prefix := systemConfigBatchPrefix(data.Domain.ValueString())
+	values := map[string]interface{}{}
+
+	for key := range owned {
+		values[prefix+key] = nil
+	}
+
+	if err := writeSystemConfig(shopware_sdk.NewApiContext(ctx), r.client, data.SalesChannelId.ValueString(), values); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete system config batch, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes all configured values and removes the previously managed keys that are no longer configured.
+func (r *SystemConfigBatchResource) upsertData(ctx context.Context, data SystemConfigBatchModel, previous map[string]string) error {
+	configured, diags := systemConfigBatchValues(ctx, data.Values)
+
+	if diags.HasError() {
+		return fmt.Errorf("unable to read values: %s", diags.Errors()[0].Detail())
+	}
+
+	prefix := systemConfigBatchPrefix(data.Domain.ValueString())
+	values := map[string]interface{}{}
+
+	for key := range previous {
+		values[prefix+key] = nil
+	}
+
+	for key, value := range configured {
+		decoded, err := internal.DecodeJson(value)
+
+		if err != nil {
+			return fmt.Errorf("value of %s must be valid JSON: %w", prefix+key, err)
+		}
+
+		values[prefix+key] = decoded
+	}
+
+	return writeSystemConfig(shopware_sdk.NewApiContext(ctx), r.client, data.SalesChannelId.ValueString(), values)
+}
+
+func (r *SystemConfigBatchResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	salesChannelId, domain, err := parseSystemConfigId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain"), domain)...)
+
+	if salesChannelId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sales_channel_id"), salesChannelId)...)
+	}
+}
+
+func systemConfigBatchPrefix(domain string) string {
+	return strings.TrimSuffix(domain, ".") + "."
+}
+
+func systemConfigBatchValues(ctx context.Context, values types.Map) (map[string]string, diag.Diagnostics) {
+	result := map[string]string{}
+
+	if values.IsNull() || values.IsUnknown() {
+		return result, nil
+	}
+
+	diags := values.ElementsAs(ctx, &result, false)
+
+	return result, diags
+}
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &SystemConfigResource{}
+var _ resource.ResourceWithImportState = &SystemConfigResource{}
+
+func NewSystemConfigResource() resource.Resource {
+	return &SystemConfigResource{}
+}
+
+// SystemConfigResource defines the resource implementation.
+type SystemConfigResource struct {
+	client *shopware_sdk.Client
+}
+
+// SystemConfigModel describes the resource data model.
+type SystemConfigModel struct {
+	Id             types.String `tfsdk:"id"`
+	Key            types.String `tfsdk:"key"`
+	SalesChannelId types.String `tfsdk:"sales_channel_id"`
+	Value          types.String `tfsdk:"value"`
+}
+
+func (r *SystemConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_system_config"
+}
+
+func (r *SystemConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "System Config value. Deleting the resource removes the value, so the inherited or default value applies again.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier in the format `[sales_channel_id:]key`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"key": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Config key, e.g. `core.basicInformation.email`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sales_channel_id": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Sales Channel ID, the value is set globally when omitted",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "JSON encoded value, e.g. `jsonencode(\"shop@example.com\")`",
+			},
+		},
+	}
+}
+
+func (r *SystemConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *SystemConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data SystemConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(systemConfigId(data.SalesChannelId.ValueString(), data.Key.ValueString()))
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create system config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemConfigResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data SystemConfigModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	key := data.Key.ValueString()
+	values, err := readSystemConfig(shopware_sdk.NewApiContext(ctx), r.client, systemConfigDomain(key), data.SalesChannelId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system config, got error: %s", err))
+		return
+	}
+
+	value, ok := values[key]
+
+	if !ok || value == nil {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	encoded, err := internal.EncodeJson(value)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read system config, got error: %s", err))
+		return
+	}
+
+	if data.Value.IsNull() || !internal.JsonEqual(data.Value.ValueString(), encoded) {
+		data.Value = types.StringValue(encoded)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemConfigResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data SystemConfigModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update system config, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *SystemConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data SystemConfigModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := writeSystemConfig(
+		shopware_sdk.NewApiContext(ctx),
+		r.client,
+		data.SalesChannelId.ValueString(),
+		map[string]interface{}{data.Key.ValueString(): nil},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete system config, got error: %s", err))
+		return
+	}
+}
+
+func (r *SystemConfigResource) upsertData(ctx context.Context, data SystemConfigModel) error {
+	value, err := internal.DecodeJson(data.Value.ValueString())
+
+	if err != nil {
+		return fmt.Errorf("value of %s must be valid JSON: %w", data.Key.ValueString(), err)
+	}
+
+	return writeSystemConfig(
+		shopware_sdk.NewApiContext(ctx),
+		r.client,
+		data.SalesChannelId.ValueString(),
+		map[string]interface{}{data.Key.ValueString(): value},
+	)
+}
+
+func (r *SystemConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	salesChannelId, key, err := parseSystemConfigId(req.ID)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Import ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("key"), key)...)
+
+	if salesChannelId != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sales_channel_id"), salesChannelId)...)
+	}
+}
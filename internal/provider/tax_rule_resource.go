@@ -0,0 +1,402 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"terraform-provider-shopware/internal"
+	"time"
+)
+
+var _ resource.Resource = &TaxRuleResource{}
+var _ resource.ResourceWithImportState = &TaxRuleResource{}
+var _ resource.ResourceWithValidateConfig = &TaxRuleResource{}
+
+func NewTaxRuleResource() resource.Resource {
+	return &TaxRuleResource{}
+}
+
+// TaxRuleResource defines the resource implementation.
+type TaxRuleResource struct {
+	client *shopware_sdk.Client
+}
+
+// TaxRuleModel describes the resource data model.
+type TaxRuleModel struct {
+	Id          types.String  `tfsdk:"id"`
+	TaxId       types.String  `tfsdk:"tax_id"`
+	CountryId   types.String  `tfsdk:"country_id"`
+	Type        types.String  `tfsdk:"type"`
+	TaxRate     types.Float64 `tfsdk:"tax_rate"`
+	ActiveFrom  types.String  `tfsdk:"active_from"`
+	StateIds    types.Set     `tfsdk:"state_ids"`
+	ZipCode     types.String  `tfsdk:"zip_code"`
+	FromZipCode types.String  `tfsdk:"from_zip_code"`
+	ToZipCode   types.String  `tfsdk:"to_zip_code"`
+}
+
+var taxRuleTypes = []string{"entire_country", "individual_states", "zip_code", "zip_code_range"}
+
+func (r *TaxRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_tax_rule"
+}
+
+func (r *TaxRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Tax Rule, overrides the rate of a tax for a country, its states or zip codes",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tax_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Tax ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"country_id": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Country ID",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Rule type, one of `entire_country`, `individual_states`, `zip_code` or `zip_code_range`",
+			},
+			"tax_rate": schema.Float64Attribute{
+				Required:            true,
+				MarkdownDescription: "Tax rate in percent",
+			},
+			"active_from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Date the rule applies from, as RFC 3339 timestamp or date like `2024-07-01`",
+			},
+			"state_ids": schema.SetAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				MarkdownDescription: "Country State IDs for the `individual_states` type",
+			},
+			"zip_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Zip code for the `zip_code` type",
+			},
+			"from_zip_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "First zip code for the `zip_code_range` type",
+			},
+			"to_zip_code": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Last zip code for the `zip_code_range` type",
+			},
+		},
+	}
+}
+
+func (r *TaxRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TaxRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data TaxRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create tax rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaxRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data TaxRuleModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	criteria := shopware_sdk.Criteria{
+		IDs:          []string{data.Id.ValueString()},
+		Associations: map[string]shopware_sdk.Criteria{"type": {}},
+	}
+	entities, _, err := r.client.Repository.TaxRule.Search(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read tax rule, got error: %s", err))
+		return
+	}
+
+	if entities.Total == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := entities.Data[0]
+
+	data.TaxId = types.StringValue(entity.TaxId)
+	data.CountryId = types.StringValue(entity.CountryId)
+	data.TaxRate = types.Float64Value(entity.TaxRate)
+	data.ActiveFrom = taxRuleActiveFrom(entity.ActiveFrom, data.ActiveFrom)
+
+	if entity.Type != nil {
+		data.Type = types.StringValue(entity.Type.TechnicalName)
+	}
+
+	ruleData, _ := entity.Data.(map[string]interface{})
+	zipCode, _ := ruleData["zipCode"].(string)
+	fromZipCode, _ := ruleData["fromZipCode"].(string)
+	toZipCode, _ := ruleData["toZipCode"].(string)
+
+	data.ZipCode = stringOrNull(zipCode)
+	data.FromZipCode = stringOrNull(fromZipCode)
+	data.ToZipCode = stringOrNull(toZipCode)
+	data.StateIds = types.SetNull(types.StringType)
+
+	if states, ok := ruleData["states"].([]interface{}); ok && len(states) > 0 {
+		stateIds := make([]string, 0)
+
+		for _, state := range states {
+			if stateId, ok := state.(string); ok {
+				stateIds = append(stateIds, stateId)
+			}
+		}
+
+		data.StateIds = stringSetValue(stateIds)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaxRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data TaxRuleModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update tax rule, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *TaxRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data TaxRuleModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.Repository.TaxRule.Delete(
+		shopware_sdk.NewApiContext(ctx),
+		[]string{data.Id.ValueString()},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete tax rule, got error: %s", err))
+		return
+	}
+}
+
+func (r *TaxRuleResource) upsertData(ctx context.Context, data TaxRuleModel) error {
+	typeId, err := r.typeId(ctx, data.Type.ValueString())
+
+	if err != nil {
+		return err
+	}
+
+	var activeFrom interface{}
+
+	if !data.ActiveFrom.IsNull() {
+		parsed, err := parseTaxRuleActiveFrom(data.ActiveFrom.ValueString())
+
+		if err != nil {
+			return err
+		}
+
+		activeFrom = parsed.Format(time.RFC3339)
+	}
+
+	ruleData := map[string]interface{}{}
+
+	switch data.Type.ValueString() {
+	case "individual_states":
+		ruleData["states"] = stringSetElements(data.StateIds)
+	case "zip_code":
+		ruleData["zipCode"] = data.ZipCode.ValueString()
+	case "zip_code_range":
+		ruleData["fromZipCode"] = data.FromZipCode.ValueString()
+		ruleData["toZipCode"] = data.ToZipCode.ValueString()
+	}
+
+	var payloadData interface{}
+
+	if len(ruleData) > 0 {
+		payloadData = ruleData
+	}
+
+	payload := map[string]interface{}{
+		"id":            data.Id.ValueString(),
+		"taxId":         data.TaxId.ValueString(),
+		"countryId":     data.CountryId.ValueString(),
+		"taxRuleTypeId": typeId,
+		"taxRate":       data.TaxRate.ValueFloat64(),
+		"activeFrom":    activeFrom,
+		"data":          payloadData,
+	}
+
+	return syncUpsert(ctx, r.client, "tax_rule", []map[string]interface{}{payload})
+}
+
+// typeId resolves the ID of a tax rule type by its technical name.
+func (r *TaxRuleResource) typeId(ctx context.Context, technicalName string) (string, error) {
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: "technicalName", Value: technicalName},
+		},
+	}
+
+	ids, _, err := r.client.Repository.TaxRuleType.SearchIds(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		return "", err
+	}
+
+	if len(ids.Data) == 0 {
+		return "", fmt.Errorf("unknown tax rule type %s", technicalName)
+	}
+
+	return ids.Data[0], nil
+}
+
+func (r *TaxRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data TaxRuleModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ActiveFrom.IsNull() && !data.ActiveFrom.IsUnknown() {
+		if _, err := parseTaxRuleActiveFrom(data.ActiveFrom.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("active_from"), "Invalid Date", err.Error())
+		}
+	}
+
+	if data.Type.IsUnknown() {
+		return
+	}
+
+	required := map[string][]string{
+		"individual_states": {"state_ids"},
+		"zip_code":          {"zip_code"},
+		"zip_code_range":    {"from_zip_code", "to_zip_code"},
+	}
+
+	configured := map[string]bool{
+		"state_ids":     !data.StateIds.IsNull(),
+		"zip_code":      !data.ZipCode.IsNull(),
+		"from_zip_code": !data.FromZipCode.IsNull(),
+		"to_zip_code":   !data.ToZipCode.IsNull(),
+	}
+
+	if !validateOneOf(resp, path.Root("type"), "Invalid Tax Rule Type", data.Type, taxRuleTypes) {
+		return
+	}
+
+	for attribute, isConfigured := range configured {
+		isRequired := len(stringSliceIntersect(required[data.Type.ValueString()], []string{attribute})) > 0
+
+		if isRequired && !isConfigured {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Missing Attribute",
+				fmt.Sprintf("%s is required for the %s type.", attribute, data.Type.ValueString()),
+			)
+		}
+
+		if !isRequired && isConfigured {
+			resp.Diagnostics.AddAttributeError(
+				path.Root(attribute),
+				"Unexpected Attribute",
+				fmt.Sprintf("%s is not used by the %s type.", attribute, data.Type.ValueString()),
+			)
+		}
+	}
+}
+
+func (r *TaxRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func parseTaxRuleActiveFrom(value string) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	parsed, err := time.Parse("2006-01-02", value)
+
+	if err != nil {
+		return time.Time{}, fmt.Errorf("expected an RFC 3339 timestamp or a date like 2024-07-01, got: %s", value)
+	}
+
+	return parsed, nil
+}
+
+// taxRuleActiveFrom keeps the configured notation of the date as long as it describes the same point in time.
+func taxRuleActiveFrom(activeFrom time.Time, prior types.String) types.String {
+	if activeFrom.IsZero() {
+		return types.StringNull()
+	}
+
+	if !prior.IsNull() {
+		if parsed, err := parseTaxRuleActiveFrom(prior.ValueString()); err == nil && parsed.Equal(activeFrom) {
+			return prior
+		}
+	}
+
+	return types.StringValue(activeFrom.Format(time.RFC3339))
+}
@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"strings"
+)
+
+// translationDefinition describes the translatable fields of an entity, translations are keyed by language ID or
+// ISO locale like `de-DE`.
+type translationDefinition struct {
+	entity     string
+	foreignKey string
+	// fields maps the Terraform attribute names to the API field names.
+	fields map[string]string
+	// hashed lists the attributes holding large content, they are compared by their hash, see hashedStringValue.
+	hashed []string
+}
+
+func (d translationDefinition) attribute() schema.MapNestedAttribute {
+	attributes := map[string]schema.Attribute{}
+
+	for name := range d.fields {
+		attributes[name] = schema.StringAttribute{
+			Optional:            true,
+			MarkdownDescription: strings.ToUpper(name[:1]) + strings.ReplaceAll(name[1:], "_", " "),
+		}
+	}
+
+	return schema.MapNestedAttribute{
+		Optional:            true,
+		MarkdownDescription: "Translations keyed by Language ID or ISO locale, e.g. `de-DE`. Languages which are not listed are left untouched.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: attributes,
+		},
+	}
+}
+
+func (d translationDefinition) objectType() types.ObjectType {
+	attributeTypes := map[string]attr.Type{}
+
+	for name := range d.fields {
+		attributeTypes[name] = types.StringType
+	}
+
+	return types.ObjectType{AttrTypes: attributeTypes}
+}
+
+// payload returns the translations association for an upsert.
+func (d translationDefinition) payload(ctx context.Context, client *shopware_sdk.Client, translations types.Map) ([]map[string]interface{}, error) {
+	payload := make([]map[string]interface{}, 0)
+	values := d.values(translations)
+
+	languageIds, err := resolveLanguageIds(ctx, client, mapKeys(values))
+
+	if err != nil {
+		return nil, err
+	}
+
+	for key, fields := range values {
+		translation := map[string]interface{}{"languageId": languageIds[key]}
+
+		for name, field := range d.fields {
+			translation[field] = optionalString(fields[name])
+		}
+
+		payload = append(payload, translation)
+	}
+
+	return payload, nil
+}
+
+// read loads the translations of the languages known in the prior state, an unset attribute stays unset.
+func (d translationDefinition) read(ctx context.Context, client *shopware_sdk.Client, id string, prior types.Map) (types.Map, error) {
+	if prior.IsNull() || prior.IsUnknown() {
+		return prior, nil
+	}
+
+	known := d.values(prior)
+
+	languageIds, err := resolveLanguageIds(ctx, client, mapKeys(known))
+
+	if err != nil {
+		return prior, err
+	}
+
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEquals, Field: d.foreignKey, Value: id},
+		},
+	}
+
+	result, err := searchEntities(ctx, client, d.entity+"_translation", criteria)
+
+	if err != nil {
+		return prior, err
+	}
+
+	objectType := d.objectType()
+	elements := map[string]attr.Value{}
+
+	for key := range known {
+		for _, translation := range result.Data {
+			if translation["languageId"] != languageIds[key] {
+				continue
+			}
+
+			attributes := map[string]attr.Value{}
+
+			for name, field := range d.fields {
+				value, _ := translation[field].(string)
+				attributes[name] = stringOrNull(value)
+
+				if len(stringSliceIntersect(d.hashed, []string{name})) > 0 {
+					attributes[name] = hashedStringValue(value, known[key][name])
+				}
+			}
+
+			element, diags := types.ObjectValue(objectType.AttrTypes, attributes)
+
+			if diags.HasError() {
+				return prior, fmt.Errorf("unable to read translation %s", key)
+			}
+
+			elements[key] = element
+		}
+	}
+
+	current, diags := types.MapValue(objectType, elements)
+
+	if diags.HasError() {
+		return prior, fmt.Errorf("unable to read translations")
+	}
+
+	return current, nil
+}
+
+// deleteRemoved deletes the translations which were dropped from the configuration since the prior state.
+func (d translationDefinition) deleteRemoved(ctx context.Context, client *shopware_sdk.Client, id string, prior types.Map, planned types.Map) error {
+	removedKeys := stringSliceDiff(mapKeys(d.values(prior)), mapKeys(d.values(planned)))
+
+	if len(removedKeys) == 0 {
+		return nil
+	}
+
+	languageIds, err := resolveLanguageIds(ctx, client, removedKeys)
+
+	if err != nil {
+		return err
+	}
+
+	removed := make([]map[string]interface{}, 0)
+
+	for _, key := range removedKeys {
+		removed = append(removed, map[string]interface{}{d.foreignKey: id, "languageId": languageIds[key]})
+	}
+
+	return syncDelete(ctx, client, d.entity+"_translation", removed)
+}
+
+func (d translationDefinition) values(translations types.Map) map[string]map[string]types.String {
+	values := map[string]map[string]types.String{}
+
+	for key, element := range translations.Elements() {
+		object, ok := element.(types.Object)
+
+		if !ok {
+			continue
+		}
+
+		fields := map[string]types.String{}
+
+		for name, value := range object.Attributes() {
+			if stringValue, ok := value.(types.String); ok {
+				fields[name] = stringValue
+			}
+		}
+
+		values[key] = fields
+	}
+
+	return values
+}
+
+// resolveLanguageIds maps language IDs to themselves and ISO locales to the ID of the language using them.
+func resolveLanguageIds(ctx context.Context, client *shopware_sdk.Client, keys []string) (map[string]string, error) {
+	languageIds := map[string]string{}
+	locales := make([]string, 0)
+
+	for _, key := range keys {
+		if uuidPattern.MatchString(key) {
+			languageIds[key] = key
+		} else {
+			locales = append(locales, key)
+		}
+	}
+
+	if len(locales) == 0 {
+		return languageIds, nil
+	}
+
+	criteria := shopware_sdk.Criteria{
+		Filter: []shopware_sdk.CriteriaFilter{
+			{Type: shopware_sdk.SearchFilterTypeEqualsAny, Field: "locale.code", Value: locales},
+		},
+		Associations: map[string]shopware_sdk.Criteria{"locale": {}},
+	}
+
+	languages, _, err := client.Repository.Language.Search(shopware_sdk.NewApiContext(ctx), criteria)
+
+	if err != nil {
+		return nil, err
+	}
+
+	for _, language := range languages.Data {
+		if language.Locale != nil {
+			languageIds[language.Locale.Code] = language.Id
+		}
+	}
+
+	for _, locale := range locales {
+		if _, ok := languageIds[locale]; !ok {
+			return nil, fmt.Errorf("no language found for locale %s", locale)
+		}
+	}
+
+	return languageIds, nil
+}
+
+func mapKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+
+	for key := range values {
+		keys = append(keys, key)
+	}
+
+	return keys
+}
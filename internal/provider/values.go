@@ -0,0 +1,223 @@
+package provider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"regexp"
+	"sort"
+	"strings"
+	"terraform-provider-shopware/internal"
+)
+
+// uuidPattern matches the hex encoded IDs of the DAL.
+var uuidPattern = regexp.MustCompile("^[0-9a-f]{32}$")
+
+// colorHexCodePattern matches the CSS hex notations the color picker of the Administration writes.
+var colorHexCodePattern = regexp.MustCompile("^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6}|[0-9a-fA-F]{8})$")
+
+func stringSetElements(set types.Set) []string {
+	values := make([]string, 0)
+
+	for _, element := range set.Elements() {
+		if value, ok := element.(types.String); ok && !value.IsUnknown() && !value.IsNull() {
+			values = append(values, value.ValueString())
+		}
+	}
+
+	return values
+}
+
+func stringListElements(list types.List) []string {
+	values := make([]string, 0)
+
+	for _, element := range list.Elements() {
+		if value, ok := element.(types.String); ok && !value.IsUnknown() && !value.IsNull() {
+			values = append(values, value.ValueString())
+		}
+	}
+
+	return values
+}
+
+func stringSetValue(values []string) types.Set {
+	sort.Strings(values)
+
+	elements := make([]attr.Value, 0, len(values))
+
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+
+	return types.SetValueMust(types.StringType, elements)
+}
+
+// stringSliceDiff returns the values of a which are missing in b.
+func stringSliceDiff(a []string, b []string) []string {
+	known := map[string]bool{}
+
+	for _, value := range b {
+		known[value] = true
+	}
+
+	diff := make([]string, 0)
+
+	for _, value := range a {
+		if !known[value] {
+			diff = append(diff, value)
+		}
+	}
+
+	return diff
+}
+
+// stringSliceIntersect returns the values of a which are also in b.
+func stringSliceIntersect(a []string, b []string) []string {
+	known := map[string]bool{}
+
+	for _, value := range b {
+		known[value] = true
+	}
+
+	intersect := make([]string, 0)
+
+	for _, value := range a {
+		if known[value] {
+			intersect = append(intersect, value)
+		}
+	}
+
+	return intersect
+}
+
+// associationIds returns the given field of all entities of a loaded association.
+func associationIds(value interface{}, field string) []string {
+	entries, _ := value.([]interface{})
+	ids := make([]string, 0)
+
+	for _, entry := range entries {
+		if associated, ok := entry.(map[string]interface{}); ok {
+			if id, ok := associated[field].(string); ok {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// optionalString maps an unset attribute to nil, so it is written as null.
+func optionalString(value types.String) interface{} {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueString()
+}
+
+// optionalBool maps an unset attribute to nil, so it is written as null.
+func optionalBool(value types.Bool) interface{} {
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+
+	return value.ValueBool()
+}
+
+// stringOrNull maps an empty API value to null for optional attributes.
+func stringOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	return types.StringValue(value)
+}
+
+// hashedStringValue reads large content like mail bodies. The prior value is kept while it has the same hash, a
+// changed value is replaced by its hash, so drift shows up in plans without the full content. The configured content
+// itself stays in the state, Terraform compares it with the configuration.
+func hashedStringValue(value string, prior types.String) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+
+	hash := sha256.Sum256([]byte(value))
+	current := "sha256:" + hex.EncodeToString(hash[:])
+
+	if !prior.IsNull() && !prior.IsUnknown() && sha256.Sum256([]byte(prior.ValueString())) == hash {
+		return prior
+	}
+
+	return types.StringValue(current)
+}
+
+// boolOrNull maps a missing API value, like an inherited flag, to null. An unset flag stays unset while it is false.
+func boolOrNull(value interface{}, prior types.Bool) types.Bool {
+	flag, ok := value.(bool)
+
+	if !ok || (!flag && prior.IsNull()) {
+		return types.BoolNull()
+	}
+
+	return types.BoolValue(flag)
+}
+
+// jsonValue encodes an API value for a JSON string attribute and keeps the prior string when it is semantically
+// unchanged, so formatting differences do not show up as drift.
+func jsonValue(value interface{}, prior types.String) (types.String, error) {
+	if value == nil {
+		return types.StringNull(), nil
+	}
+
+	encoded, err := internal.EncodeJson(value)
+
+	if err != nil {
+		return types.StringNull(), err
+	}
+
+	if !prior.IsNull() && !prior.IsUnknown() && internal.JsonEqual(prior.ValueString(), encoded) {
+		return prior, nil
+	}
+
+	return types.StringValue(encoded), nil
+}
+
+// optionalJson decodes a JSON string attribute for a payload, an unset attribute is written as null.
+func optionalJson(value types.String) (interface{}, error) {
+	if value.IsNull() || value.IsUnknown() {
+		return nil, nil
+	}
+
+	return internal.DecodeJson(value.ValueString())
+}
+
+// validateOneOf reports a configured value which is not part of the allowed values and returns whether it is valid.
+func validateOneOf(resp *resource.ValidateConfigResponse, attribute path.Path, summary string, value types.String, allowed []string) bool {
+	if value.IsNull() || value.IsUnknown() || len(stringSliceIntersect(allowed, []string{value.ValueString()})) > 0 {
+		return true
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		attribute,
+		summary,
+		fmt.Sprintf("Expected one of %s, got: %s", strings.Join(allowed, ", "), value.ValueString()),
+	)
+
+	return false
+}
+
+func validateColorHexCode(resp *resource.ValidateConfigResponse, attribute path.Path, value types.String) {
+	if value.IsNull() || value.IsUnknown() || colorHexCodePattern.MatchString(value.ValueString()) {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		attribute,
+		"Invalid Color",
+		fmt.Sprintf("Expected a hex color like #ff0000, got: %s", value.ValueString()),
+	)
+}
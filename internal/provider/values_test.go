@@ -0,0 +1,56 @@
+package provider
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"testing"
+)
+
+func TestHashedStringValue(t *testing.T) {
+	changedHash := "sha256:0240d9b6e64fc4ea6838e8374b9c0c3de513f5967723c4796714f063eff7d11f"
+
+	tests := []struct {
+		name     string
+		value    string
+		prior    types.String
+		expected types.String
+	}{
+		{
+			name:     "empty content is null",
+			value:    "",
+			prior:    types.StringValue("<p>Hello</p>"),
+			expected: types.StringNull(),
+		},
+		{
+			name:     "unchanged content keeps the configured value",
+			value:    "<p>Hello</p>",
+			prior:    types.StringValue("<p>Hello</p>"),
+			expected: types.StringValue("<p>Hello</p>"),
+		},
+		{
+			name:     "changed content shows up as its hash",
+			value:    "<p>Changed</p>",
+			prior:    types.StringValue("<p>Hello</p>"),
+			expected: types.StringValue(changedHash),
+		},
+		{
+			name:     "imported content shows up as its hash",
+			value:    "<p>Changed</p>",
+			prior:    types.StringNull(),
+			expected: types.StringValue(changedHash),
+		},
+		{
+			name:     "a stored hash stays stable",
+			value:    "<p>Changed</p>",
+			prior:    types.StringValue(changedHash),
+			expected: types.StringValue(changedHash),
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if actual := hashedStringValue(test.value, test.prior); !actual.Equal(test.expected) {
+				t.Errorf("expected %s, got %s", test.expected, actual)
+			}
+		})
+	}
+}
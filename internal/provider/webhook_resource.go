@@ -0,0 +1,261 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	shopware_sdk "github.com/friendsofshopware/go-shopware-admin-api-sdk"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"net/url"
+	"terraform-provider-shopware/internal"
+)
+
+var _ resource.Resource = &WebhookResource{}
+var _ resource.ResourceWithImportState = &WebhookResource{}
+var _ resource.ResourceWithValidateConfig = &WebhookResource{}
+var _ resource.ResourceWithModifyPlan = &WebhookResource{}
+
+func NewWebhookResource() resource.Resource {
+	return &WebhookResource{}
+}
+
+// WebhookResource defines the resource implementation.
+type WebhookResource struct {
+	client *shopware_sdk.Client
+}
+
+// WebhookModel describes the resource data model.
+type WebhookModel struct {
+	Id         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	EventName  types.String `tfsdk:"event_name"`
+	Url        types.String `tfsdk:"url"`
+	Active     types.Bool   `tfsdk:"active"`
+	ErrorCount types.Int64  `tfsdk:"error_count"`
+}
+
+func (r *WebhookResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_webhook"
+}
+
+func (r *WebhookResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Webhook without an app, calls the URL whenever the event is dispatched",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				MarkdownDescription: "Identifier",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Name",
+			},
+			"event_name": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "Event, e.g. `product.written` or `checkout.order.placed`",
+			},
+			"url": schema.StringAttribute{
+				Required:            true,
+				MarkdownDescription: "URL receiving the event payload",
+			},
+			"active": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				MarkdownDescription: "Active, Shopware deactivates webhooks which failed too often",
+			},
+			"error_count": schema.Int64Attribute{
+				Computed:            true,
+				MarkdownDescription: "Number of failed calls since the last successful one",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *WebhookResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*shopware_sdk.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan plans the reset of the error count when a deactivated webhook is activated again, see upsertData.
+func (r *WebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state WebhookModel
+	var active types.Bool
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("active"), &active)...)
+
+	if resp.Diagnostics.HasError() || state.Active.ValueBool() || !active.ValueBool() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("error_count"), types.Int64Value(0))...)
+}
+
+func (r *WebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data WebhookModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(internal.NewUuid())
+	data.ErrorCount = types.Int64Value(0)
+
+	if err := r.upsertData(ctx, data, WebhookModel{}); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create webhook, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data WebhookModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, err := searchEntities(ctx, r.client, "webhook", shopware_sdk.Criteria{IDs: []string{data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read webhook, got error: %s", err))
+		return
+	}
+
+	if len(result.Data) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	entity := result.Data[0]
+
+	name, _ := entity["name"].(string)
+	eventName, _ := entity["eventName"].(string)
+	webhookUrl, _ := entity["url"].(string)
+	active, _ := entity["active"].(bool)
+	errorCount, _ := entity["errorCount"].(float64)
+
+	data.Name = types.StringValue(name)
+	data.EventName = types.StringValue(eventName)
+	data.Url = types.StringValue(webhookUrl)
+	data.Active = types.BoolValue(active)
+	data.ErrorCount = types.Int64Value(int64(errorCount))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var data WebhookModel
+	var state WebhookModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := r.upsertData(ctx, data, state); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update webhook, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *WebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data WebhookModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := syncDelete(ctx, r.client, "webhook", []map[string]interface{}{{"id": data.Id.ValueString()}})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete webhook, got error: %s", err))
+		return
+	}
+}
+
+// upsertData writes the webhook. The error count is maintained by Shopware, it is only reset when a deactivated webhook
+// is activated again, as Shopware would deactivate it again on the next failure otherwise.
+func (r *WebhookResource) upsertData(ctx context.Context, data WebhookModel, prior WebhookModel) error {
+	payload := map[string]interface{}{
+		"id":        data.Id.ValueString(),
+		"name":      data.Name.ValueString(),
+		"eventName": data.EventName.ValueString(),
+		"url":       data.Url.ValueString(),
+		"active":    data.Active.ValueBool(),
+	}
+
+	if !prior.Id.IsNull() && !prior.Active.ValueBool() && data.Active.ValueBool() {
+		payload["errorCount"] = 0
+	}
+
+	return syncUpsert(ctx, r.client, "webhook", []map[string]interface{}{payload})
+}
+
+func (r *WebhookResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data WebhookModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Url.IsNull() || data.Url.IsUnknown() {
+		return
+	}
+
+	parsed, err := url.Parse(data.Url.ValueString())
+
+	if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("url"),
+			"Invalid URL",
+			fmt.Sprintf("Expected an absolute http or https URL, got: %s", data.Url.ValueString()),
+		)
+	}
+}
+
+func (r *WebhookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
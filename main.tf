@@ -17,10 +17,18 @@ resource "shopware_rule" "my_rule" {
   type     = ["shipping"]
 
   conditions {
-    type  = "customerAffiliateCode"
-    value = {
-      operator = "="
-      affiliateCode    = "123"
+    type = "orContainer"
+
+    conditions {
+      type = "andContainer"
+
+      conditions {
+        type = "customerAffiliateCode"
+        value = jsonencode({
+          operator      = "="
+          affiliateCode = "123"
+        })
+      }
     }
   }
 }
@@ -31,4 +39,16 @@ resource "shopware_shipping_method" "bla" {
   active               = true
   delivery_time_id     = shopware_delivery_time.test.id
   availability_rule_id = shopware_rule.my_rule.id
-}
\ No newline at end of file
+}
+resource "shopware_system_config" "shop_email" {
+  key   = "core.basicInformation.email"
+  value = jsonencode("shop@example.com")
+}
+
+resource "shopware_system_config_batch" "cart" {
+  domain = "core.cart."
+  values = {
+    maxQuantity     = jsonencode(100)
+    wishlistEnabled = jsonencode(true)
+  }
+}